@@ -0,0 +1,187 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	"github.com/fatih/color"
+	"github.com/urfave/cli"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"time"
+)
+
+const defaultCacheTTL int = 300 // seconds
+
+var cacheKeyChars = regexp.MustCompile(`[^A-Za-z0-9._-]`)
+
+// cacheDir returns the cache directory of the .edgerc file and section in use
+func cacheDir(c *cli.Context) string {
+
+	sum := sha256.Sum256([]byte(c.GlobalString("edgerc")))
+	section := cacheKeyChars.ReplaceAllString(c.GlobalString("section"), "_")
+	return filepath.Join(cliConfigDir(), "cache", section+"-"+hex.EncodeToString(sum[:4]))
+}
+
+// cacheFile returns the cache file for key
+func cacheFile(c *cli.Context, key string) string {
+
+	return filepath.Join(cacheDir(c), cacheKeyChars.ReplaceAllString(key, "_")+".json")
+}
+
+// cacheTTL returns the cache TTL in seconds from the CLI config file, or the default
+func cacheTTL() int {
+
+	if cliConfig, err := loadCLIConfig(); err == nil && cliConfig.CacheTTL > 0 {
+		return cliConfig.CacheTTL
+	}
+	return defaultCacheTTL
+}
+
+// readCache loads the cached value of key into v. Returns false if not cached, older than the cache TTL or --no-cache is specified.
+func readCache(c *cli.Context, key string, v interface{}) bool {
+
+	if c.Bool("no-cache") {
+		return false
+	}
+	file := cacheFile(c, key)
+	info, err := os.Stat(file)
+	if err != nil || time.Since(info.ModTime()) > time.Duration(cacheTTL())*time.Second {
+		return false
+	}
+	data, err := ioutil.ReadFile(file)
+	if err != nil {
+		return false
+	}
+	return json.Unmarshal(data, v) == nil
+}
+
+// writeCache saves v as the cached value of key. Caching is best effort.
+func writeCache(c *cli.Context, key string, v interface{}) {
+
+	data, err := json.Marshal(v)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(cacheDir(c), 0700); err != nil {
+		return
+	}
+	ioutil.WriteFile(cacheFile(c, key), data, 0600)
+}
+
+// invalidateDomainCache removes the cached domain configuration. Called after the domain is changed.
+func invalidateDomainCache(c *cli.Context, domainName string) {
+
+	os.Remove(cacheFile(c, "domain-"+domainName))
+}
+
+// clearCache removes the cache of the .edgerc file and section in use, or the cache of all sections
+func clearCache(c *cli.Context, all bool) error {
+
+	if all {
+		return os.RemoveAll(filepath.Join(cliConfigDir(), "cache"))
+	}
+	return os.RemoveAll(cacheDir(c))
+}
+
+// cachedDomainNames returns the names of the domains accessible with the credentials in use
+func cachedDomainNames(c *cli.Context) ([]string, error) {
+
+	var names []string
+	if readCache(c, "domains", &names) {
+		return names, nil
+	}
+	domList, err := configgtm.ListDomains()
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range domList {
+		names = append(names, d.Name)
+	}
+	writeCache(c, "domains", names)
+	return names, nil
+}
+
+// redactDomainSecrets returns a copy of the domain without liveness test passwords and SSL client private keys
+func redactDomainSecrets(dom *configgtm.Domain) *configgtm.Domain {
+
+	redacted := *dom
+	redacted.DefaultSslClientPrivateKey = ""
+	redacted.Properties = nil
+	for _, prop := range dom.Properties {
+		propCopy := *prop
+		propCopy.LivenessTests = nil
+		for _, lt := range prop.LivenessTests {
+			ltCopy := *lt
+			ltCopy.TestObjectPassword = ""
+			ltCopy.SslClientPrivateKey = ""
+			propCopy.LivenessTests = append(propCopy.LivenessTests, &ltCopy)
+		}
+		redacted.Properties = append(redacted.Properties, &propCopy)
+	}
+	return &redacted
+}
+
+// cachedDomain returns the domain configuration with secrets removed. The result may be stale
+// and must not be used to update the domain.
+func cachedDomain(c *cli.Context, domainName string) (*configgtm.Domain, error) {
+
+	dom := &configgtm.Domain{}
+	if readCache(c, "domain-"+domainName, dom) {
+		return dom, nil
+	}
+	dom, err := configgtm.GetDomain(domainName)
+	if err != nil {
+		return nil, err
+	}
+	dom = redactDomainSecrets(dom)
+	writeCache(c, "domain-"+domainName, dom)
+	return dom, nil
+}
+
+// cachedDatacenters returns the domain datacenters
+func cachedDatacenters(c *cli.Context, domainName string) ([]*configgtm.Datacenter, error) {
+
+	var dcList []*configgtm.Datacenter
+	if readCache(c, "datacenters-"+domainName, &dcList) {
+		return dcList, nil
+	}
+	dcList, err := configgtm.ListDatacenters(domainName)
+	if err != nil {
+		return nil, err
+	}
+	writeCache(c, "datacenters-"+domainName, dcList)
+	return dcList, nil
+}
+
+// worker function for cache clear
+func cmdCacheClear(c *cli.Context) error {
+
+	if err := clearCache(c, c.Bool("all")); err != nil {
+		return cli.NewExitError(color.RedString("Unable to clear cache. "+err.Error()), 1)
+	}
+	if !c.Bool("all") {
+		fmt.Fprintln(c.App.Writer, fmt.Sprintf("Cache cleared for section %s", c.GlobalString("section")))
+	} else {
+		fmt.Fprintln(c.App.Writer, "Cache cleared")
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	"strings"
+	"testing"
+)
+
+func TestRedactDomainSecrets(t *testing.T) {
+
+	dom := &configgtm.Domain{Name: "example.akadns.net", DefaultSslClientPrivateKey: "domain-key", Properties: []*configgtm.Property{
+		{Name: "www", LivenessTests: []*configgtm.LivenessTest{{Name: "https", TestObjectUsername: "monitor", TestObjectPassword: "secret", SslClientPrivateKey: "test-key"}}},
+		{Name: "api"},
+	}}
+	redacted := redactDomainSecrets(dom)
+	data, err := json.Marshal(redacted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"domain-key", "secret", "test-key"} {
+		if strings.Contains(string(data), secret) {
+			t.Errorf("redacted domain contains %s: %s", secret, data)
+		}
+	}
+	if len(redacted.Properties) != 2 || redacted.Properties[0].LivenessTests[0].TestObjectUsername != "monitor" {
+		t.Errorf("unexpected redacted domain %s", data)
+	}
+	if dom.DefaultSslClientPrivateKey != "domain-key" || dom.Properties[0].LivenessTests[0].TestObjectPassword != "secret" || dom.Properties[0].LivenessTests[0].SslClientPrivateKey != "test-key" {
+		t.Error("original domain modified")
+	}
+}
@@ -0,0 +1,244 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	chartSparkline = "sparkline"
+	chartStacked   = "stacked"
+)
+
+// maximum chart columns. Longer series are summed into fewer columns.
+const chartWidth int = 60
+
+// stacked chart rows
+const chartHeight int = 12
+
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// stacked chart series symbols
+var chartSymbols = []rune("#*o+=%@x&$")
+
+// chartFlag is the value of --chart. The style is optional: --chart or --chart=stacked.
+type chartFlag struct {
+	style string
+}
+
+// String returns the chart style
+func (f *chartFlag) String() string {
+
+	return f.style
+}
+
+// Set sets the chart style. A value of true is a sparkline chart.
+func (f *chartFlag) Set(value string) error {
+
+	switch value {
+	case "true", chartSparkline:
+		f.style = chartSparkline
+	case "false":
+		f.style = ""
+	case chartStacked:
+		f.style = chartStacked
+	default:
+		return fmt.Errorf("Invalid chart style %s. Acceptable values: sparkline, stacked", value)
+	}
+	return nil
+}
+
+// IsBoolFlag allows --chart to be specified without a style
+func (f *chartFlag) IsBoolFlag() bool {
+
+	return true
+}
+
+// chartSeries is a labelled request series
+type chartSeries struct {
+	label  string
+	values map[string]int64
+}
+
+// chartData is the request series to chart, keyed by timestamp
+type chartData struct {
+	series []*chartSeries
+	times  map[string]bool
+}
+
+// newChartData returns empty chart data
+func newChartData() *chartData {
+
+	return &chartData{times: make(map[string]bool)}
+}
+
+// add adds requests at timestamp ts to the series labelled label
+func (d *chartData) add(label string, ts string, requests int64) {
+
+	var series *chartSeries
+	for _, s := range d.series {
+		if s.label == label {
+			series = s
+			break
+		}
+	}
+	if series == nil {
+		series = &chartSeries{label: label, values: make(map[string]int64)}
+		d.series = append(d.series, series)
+	}
+	series.values[ts] += requests
+	d.times[ts] = true
+}
+
+// columns returns the ordered timestamps and the series values, summed into at most chartWidth columns.
+// The timestamp of a column is the timestamp of its first value.
+func (d *chartData) columns() ([]string, [][]int64) {
+
+	var times []string
+	for ts := range d.times {
+		times = append(times, ts)
+	}
+	sort.Strings(times)
+	group := (len(times) + chartWidth - 1) / chartWidth
+	if group < 1 {
+		group = 1
+	}
+	var colTimes []string
+	values := make([][]int64, len(d.series))
+	for i := 0; i < len(times); i += group {
+		colTimes = append(colTimes, times[i])
+		for s, series := range d.series {
+			var sum int64
+			for j := i; j < i+group && j < len(times); j++ {
+				sum += series.values[times[j]]
+			}
+			values[s] = append(values[s], sum)
+		}
+	}
+	return colTimes, values
+}
+
+// renderChart renders the series as sparklines or a stacked chart. displayTime formats the timestamps.
+func renderChart(d *chartData, style string, displayTime func(string) string) string {
+
+	if len(d.series) == 0 || len(d.times) == 0 {
+		return fmt.Sprintln("No traffic data to chart")
+	}
+	times, values := d.columns()
+	if style == chartStacked {
+		return renderStackedChart(d, times, values, displayTime)
+	}
+	return renderSparklines(d, times, values, displayTime)
+}
+
+// renderSparklines renders one sparkline per series. Each series is scaled to its maximum; zero requests are blank.
+func renderSparklines(d *chartData, times []string, values [][]int64, displayTime func(string) string) string {
+
+	labelWidth := 0
+	for _, series := range d.series {
+		if len(series.label) > labelWidth {
+			labelWidth = len(series.label)
+		}
+	}
+	var outString string
+	outString += fmt.Sprintln(fmt.Sprintf("%-*s  %s .. %s", labelWidth, "", displayTime(times[0]), displayTime(times[len(times)-1])))
+	for s, series := range d.series {
+		var max, min, total int64
+		min = math.MaxInt64
+		for _, v := range values[s] {
+			total += v
+			if v > max {
+				max = v
+			}
+			if v < min {
+				min = v
+			}
+		}
+		line := make([]rune, len(values[s]))
+		for i, v := range values[s] {
+			switch {
+			case v == 0:
+				line[i] = ' '
+			case max == 0:
+				line[i] = sparkBlocks[0]
+			default:
+				line[i] = sparkBlocks[int(math.Round(float64(v)/float64(max)*float64(len(sparkBlocks)-1)))]
+			}
+		}
+		outString += fmt.Sprintln(fmt.Sprintf("%-*s  %s  min %d max %d total %d", labelWidth, series.label, string(line), min, max, total))
+	}
+	return outString
+}
+
+// renderStackedChart renders the series stacked by column, each series drawn with its own symbol
+func renderStackedChart(d *chartData, times []string, values [][]int64, displayTime func(string) string) string {
+
+	totals := make([]int64, len(times))
+	var max int64
+	for i := range times {
+		for s := range d.series {
+			totals[i] += values[s][i]
+		}
+		if totals[i] > max {
+			max = totals[i]
+		}
+	}
+	// series s occupies rows (top[s-1], top[s]] of each column
+	grid := make([][]rune, chartHeight)
+	for r := range grid {
+		grid[r] = []rune(strings.Repeat(" ", len(times)))
+	}
+	for i := range times {
+		var cum int64
+		prevTop := 0
+		for s := range d.series {
+			cum += values[s][i]
+			top := 0
+			if max > 0 {
+				top = int(math.Round(float64(cum) / float64(max) * float64(chartHeight)))
+			}
+			for r := prevTop; r < top; r++ {
+				grid[chartHeight-1-r][i] = chartSymbols[s%len(chartSymbols)]
+			}
+			prevTop = top
+		}
+	}
+
+	axisWidth := len(strconv.FormatInt(max, 10))
+	var outString string
+	for r, row := range grid {
+		label := ""
+		if r == 0 {
+			label = strconv.FormatInt(max, 10)
+		} else if r == chartHeight-1 {
+			label = "0"
+		}
+		outString += fmt.Sprintln(fmt.Sprintf("%*s |%s", axisWidth, label, string(row)))
+	}
+	outString += fmt.Sprintln(fmt.Sprintf("%*s +%s", axisWidth, "", strings.Repeat("-", len(times))))
+	outString += fmt.Sprintln(fmt.Sprintf("%*s  %s .. %s", axisWidth, "", displayTime(times[0]), displayTime(times[len(times)-1])))
+	outString += fmt.Sprintln(" ")
+	var legend []string
+	for s, series := range d.series {
+		legend = append(legend, fmt.Sprintf("%c %s", chartSymbols[s%len(chartSymbols)], series.label))
+	}
+	outString += fmt.Sprintln("Legend: " + strings.Join(legend, "   "))
+	return outString
+}
@@ -0,0 +1,166 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// identityTime returns ts unchanged
+func identityTime(ts string) string {
+
+	return ts
+}
+
+func TestChartFlagSet(t *testing.T) {
+
+	tests := []struct {
+		value   string
+		want    string
+		wantErr bool
+	}{
+		{"true", chartSparkline, false},
+		{"sparkline", chartSparkline, false},
+		{"stacked", chartStacked, false},
+		{"false", "", false},
+		{"bars", "", true},
+		{"", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			f := &chartFlag{}
+			err := f.Set(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Set(%q) error = %v, want error %v", tt.value, err, tt.wantErr)
+			}
+			if err != nil && !strings.Contains(err.Error(), "Invalid chart style "+tt.value) {
+				t.Errorf("unexpected error %s", err)
+			}
+			if f.String() != tt.want {
+				t.Errorf("style %q, want %q", f.String(), tt.want)
+			}
+		})
+	}
+}
+
+func TestChartDataColumns(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		points    int
+		wantCols  int
+		wantFirst []int64
+		wantTimes []string
+	}{
+		{"short series", 3, 3, []int64{1, 2, 3}, []string{"t000", "t001", "t002"}},
+		{"chart width", chartWidth, chartWidth, []int64{1, 2, 3}, []string{"t000", "t001", "t002"}},
+		{"grouped in pairs", chartWidth + 1, 31, []int64{1 + 2, 3 + 4, 5 + 6}, []string{"t000", "t002", "t004"}},
+		{"grouped in threes", 3*chartWidth - 5, 59, []int64{1 + 2 + 3, 4 + 5 + 6, 7 + 8 + 9}, []string{"t000", "t003", "t006"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newChartData()
+			for i := 0; i < tt.points; i++ {
+				d.add("east", fmt.Sprintf("t%03d", i), int64(i+1))
+			}
+			times, values := d.columns()
+			if len(times) != tt.wantCols || len(values[0]) != tt.wantCols {
+				t.Fatalf("%d times and %d values, want %d columns", len(times), len(values[0]), tt.wantCols)
+			}
+			if !reflect.DeepEqual(values[0][:3], tt.wantFirst) || !reflect.DeepEqual(times[:3], tt.wantTimes) {
+				t.Errorf("first columns %v %v, want %v %v", times[:3], values[0][:3], tt.wantTimes, tt.wantFirst)
+			}
+			var total int64
+			for _, v := range values[0] {
+				total += v
+			}
+			if want := int64(tt.points * (tt.points + 1) / 2); total != want {
+				t.Errorf("column total %d, want %d", total, want)
+			}
+		})
+	}
+
+	// requests of a series at the same timestamp are summed; missing timestamps are zero
+	d := newChartData()
+	d.add("east", "t1", 5)
+	d.add("east", "t1", 7)
+	d.add("west", "t2", 3)
+	times, values := d.columns()
+	if !reflect.DeepEqual(times, []string{"t1", "t2"}) || !reflect.DeepEqual(values, [][]int64{{12, 0}, {0, 3}}) {
+		t.Errorf("columns() = %v %v", times, values)
+	}
+}
+
+func TestRenderSparklines(t *testing.T) {
+
+	d := newChartData()
+	for i, v := range []int64{0, 1, 4, 8} {
+		d.add("east", fmt.Sprintf("t%d", i), v)
+		d.add("idle", fmt.Sprintf("t%d", i), 0)
+	}
+	times, values := d.columns()
+	out := renderSparklines(d, times, values, identityTime)
+	lines := strings.Split(strings.TrimRight(out, "\n"), "\n")
+	want := []string{
+		"      t0 .. t3",
+		"east   ▂▅█  min 0 max 8 total 13",
+		"idle        min 0 max 0 total 0",
+	}
+	if !reflect.DeepEqual(lines, want) {
+		t.Errorf("renderSparklines() =\n%s\nwant\n%s", strings.Join(lines, "\n"), strings.Join(want, "\n"))
+	}
+	if out := renderChart(newChartData(), chartSparkline, identityTime); out != "No traffic data to chart\n" {
+		t.Errorf("renderChart() of no data = %q", out)
+	}
+}
+
+func TestRenderStackedChart(t *testing.T) {
+
+	d := newChartData()
+	// column totals 12, 6 and 0: the first column fills the chart height, the second half of it
+	d.add("east", "t0", 9)
+	d.add("west", "t0", 3)
+	d.add("east", "t1", 3)
+	d.add("west", "t1", 3)
+	d.add("east", "t2", 0)
+	times, values := d.columns()
+	out := renderStackedChart(d, times, values, identityTime)
+	lines := strings.Split(strings.TrimRight(out, "\n"), "\n")
+	if len(lines) != chartHeight+4 {
+		t.Fatalf("%d lines, want %d:\n%s", len(lines), chartHeight+4, out)
+	}
+	var rows []string
+	for _, line := range lines[:chartHeight] {
+		rows = append(rows, strings.SplitN(line, "|", 2)[1])
+	}
+	want := []string{
+		"*  ", "*  ", "*  ",
+		"#  ", "#  ", "#  ",
+		"#* ", "#* ", "#* ",
+		"## ", "## ", "## ",
+	}
+	if !reflect.DeepEqual(rows, want) {
+		t.Errorf("rows %q, want %q", rows, want)
+	}
+	if !strings.HasPrefix(lines[0], "12 |") || !strings.HasPrefix(lines[chartHeight-1], " 0 |") {
+		t.Errorf("unexpected axis labels %q %q", lines[0], lines[chartHeight-1])
+	}
+	if lines[chartHeight] != "   +---" || lines[len(lines)-1] != "Legend: # east   * west" {
+		t.Errorf("unexpected axis or legend:\n%s", out)
+	}
+}
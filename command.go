@@ -29,6 +29,7 @@ type arrayFlags struct {
 	flagList       []int
 	flagStringList []string
 	nicknamesList  []string
+	groupsList     []string
 }
 
 type TargetFlags struct {
@@ -37,6 +38,8 @@ type TargetFlags struct {
 }
 
 var dcFlags arrayFlags
+var qsWatch watchInterval
+var chartFlags chartFlag
 var targetFlags TargetFlags
 
 func (i *arrayFlags) String() string {
@@ -65,6 +68,11 @@ func (i *arrayFlags) Set(value string) error {
 			return nil
 		}
 	}
+	// datacenter group (@name) or attribute selector (attribute=value). resolved with nicknames
+	if strings.HasPrefix(value, "@") || strings.Contains(value, "=") {
+		i.groupsList = append(i.groupsList, value)
+		return nil
+	}
 	// See if its an id vs nickname
 	intVal, err := strconv.Atoi(value)
 	if err != nil {
@@ -146,7 +154,7 @@ var commandLocator akamai.CommandLocator = func() ([]cli.Command, error) {
 		Flags: []cli.Flag{
 			cli.GenericFlag{
 				Name:  "datacenter",
-				Usage: "Apply change to specified datacenter traffic target in all property references by id or nickname.",
+				Usage: "Apply change to specified datacenter traffic target in all property references by id, nickname, @group or attribute=value.",
 				Value: &dcFlags,
 			},
 			cli.BoolTFlag{
@@ -178,8 +186,24 @@ var commandLocator akamai.CommandLocator = func() ([]cli.Command, error) {
 				Name:  "dryrun",
 				Usage: "Return planned datacenter traffic target change(s).",
 			},
+			cli.BoolFlag{
+				Name:  "force",
+				Usage: "Apply change(s) even if a property would be left with no enabled, weighted or alive traffic target.",
+			},
+			cli.StringFlag{
+				Name:  "policy",
+				Usage: "Policy file evaluated against planned changes. Defaults to ~/.akamai-gtm/policy.json if present.",
+			},
+			cli.StringFlag{
+				Name:  "override-policy",
+				Usage: "Apply change(s) despite policy violations. The specified reason is recorded in ~/.akamai-gtm/audit.log.",
+			},
+			cli.BoolFlag{
+				Name:  "yes",
+				Usage: "Apply change(s) without confirmation. Required when stdin is not a terminal.",
+			},
 		},
-		BashComplete: akamai.DefaultAutoComplete,
+		BashComplete: cmdAutoComplete,
 	})
 
 	commands = append(commands, cli.Command{
@@ -190,12 +214,20 @@ var commandLocator akamai.CommandLocator = func() ([]cli.Command, error) {
 		Flags: []cli.Flag{
 			cli.GenericFlag{
 				Name:  "datacenter",
-				Usage: "Apply change to specified datacenter traffic target by id or nickname. Multiple datacenters may be specified.",
+				Usage: "Apply change to specified datacenter traffic target by id, nickname, @group or attribute=value. Multiple datacenters may be specified.",
 				Value: &dcFlags,
 			},
 			cli.StringSliceFlag{
 				Name:  "liveness_test",
-				Usage: "Apply change to specified liveness test. Multiple liveness tests may be specified.",
+				Usage: "Apply change to specified liveness test by exact name. Multiple liveness tests may be specified.",
+			},
+			cli.StringSliceFlag{
+				Name:  "liveness-test-regex",
+				Usage: "Apply change to liveness tests whose name matches the specified regular expression. Multiple expressions may be specified.",
+			},
+			cli.BoolFlag{
+				Name:  "all-liveness-tests",
+				Usage: "Apply change to all property liveness tests.",
 			},
 			cli.BoolTFlag{
 				Name:  "enable",
@@ -218,6 +250,18 @@ var commandLocator akamai.CommandLocator = func() ([]cli.Command, error) {
 				Name:  "server",
 				Usage: "Update server for specified datacenter traffic target. Multiple server flags may be specified.",
 			},
+			cli.StringSliceFlag{
+				Name:  "add-server",
+				Usage: "Add server to specified datacenter traffic target(s). Multiple add-server flags may be specified.",
+			},
+			cli.StringSliceFlag{
+				Name:  "remove-server",
+				Usage: "Remove server from specified datacenter traffic target(s). Multiple remove-server flags may be specified.",
+			},
+			cli.StringSliceFlag{
+				Name:  "set",
+				Usage: "Update property setting specified as key=value, e.g. handoutLimit=2. Multiple set flags may be specified.",
+			},
 			cli.BoolFlag{
 				Name:  "verbose",
 				Usage: "Display verbose result status.",
@@ -239,8 +283,326 @@ var commandLocator akamai.CommandLocator = func() ([]cli.Command, error) {
 				Name:  "dryrun",
 				Usage: "Return planned property change(s).",
 			},
+			cli.BoolFlag{
+				Name:  "force",
+				Usage: "Apply change(s) even if a property would be left with no enabled, weighted or alive traffic target.",
+			},
+			cli.StringFlag{
+				Name:  "policy",
+				Usage: "Policy file evaluated against planned changes. Defaults to ~/.akamai-gtm/policy.json if present.",
+			},
+			cli.StringFlag{
+				Name:  "override-policy",
+				Usage: "Apply change(s) despite policy violations. The specified reason is recorded in ~/.akamai-gtm/audit.log.",
+			},
+			cli.BoolFlag{
+				Name:  "yes",
+				Usage: "Apply change(s) without confirmation. Required when stdin is not a terminal.",
+			},
 		},
-		BashComplete: akamai.DefaultAutoComplete,
+		BashComplete: cmdAutoComplete,
+	})
+
+	commands = append(commands, cli.Command{
+		Name:        "update-liveness-tests",
+		Description: "Update liveness test configuration in all properties",
+		ArgsUsage:   "<domain>",
+		Action:      cmdUpdateLivenessTests,
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "name",
+				Usage: "Select liveness tests whose name matches the specified regular expression.",
+			},
+			cli.StringSliceFlag{
+				Name:  "protocol",
+				Usage: "Select liveness tests with the specified test object protocol. Multiple protocols may be specified.",
+			},
+			cli.StringFlag{
+				Name:  "test-object",
+				Usage: "Select liveness tests with the specified test object.",
+			},
+			cli.StringFlag{
+				Name:  "path",
+				Usage: "Apply 'test object' path to selected liveness tests.",
+			},
+			cli.IntFlag{
+				Name:  "port",
+				Usage: "Apply 'test object port' to selected liveness tests.",
+			},
+			cli.IntFlag{
+				Name:  "interval",
+				Usage: "Apply 'test interval' in seconds to selected liveness tests.",
+			},
+			cli.Float64Flag{
+				Name:  "test-timeout",
+				Usage: "Apply 'test timeout' in seconds to selected liveness tests.",
+			},
+			cli.StringFlag{
+				Name:  "host-header",
+				Usage: "Apply Host HTTP header value to selected liveness tests.",
+			},
+			cli.BoolTFlag{
+				Name:  "enable",
+				Usage: "Enable selected liveness tests.",
+			},
+			cli.BoolFlag{
+				Name:  "disable",
+				Usage: "Disable selected liveness tests.",
+			},
+			cli.BoolFlag{
+				Name:  "verbose",
+				Usage: "Display verbose result status.",
+			},
+			cli.BoolFlag{
+				Name:  "json",
+				Usage: "Return status in JSON format.",
+			},
+			cli.BoolFlag{
+				Name:  "complete",
+				Usage: "Wait for change completion.",
+			},
+			cli.IntFlag{
+				Name:  "timeout",
+				Usage: "Change completion wait timeout in seconds.",
+				Value: 300,
+			},
+			cli.BoolFlag{
+				Name:  "dryrun",
+				Usage: "Return planned liveness test change(s).",
+			},
+			cli.StringFlag{
+				Name:  "policy",
+				Usage: "Policy file evaluated against planned changes. Defaults to ~/.akamai-gtm/policy.json if present.",
+			},
+			cli.StringFlag{
+				Name:  "override-policy",
+				Usage: "Apply change(s) despite policy violations. The specified reason is recorded in ~/.akamai-gtm/audit.log.",
+			},
+			cli.BoolFlag{
+				Name:  "yes",
+				Usage: "Apply change(s) without confirmation. Required when stdin is not a terminal.",
+			},
+		},
+		BashComplete: cmdAutoComplete,
+	})
+
+	commands = append(commands, cli.Command{
+		Name:        "replace-server",
+		Description: "Replace server in all property traffic targets",
+		ArgsUsage:   "<domain>",
+		Action:      cmdReplaceServer,
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "old",
+				Usage: "Server IP address to replace.",
+			},
+			cli.StringFlag{
+				Name:  "new",
+				Usage: "Replacement server IP address.",
+			},
+			cli.GenericFlag{
+				Name:  "datacenter",
+				Usage: "Limit replacement to specified datacenter traffic target by id, nickname, @group or attribute=value. Multiple datacenters may be specified.",
+				Value: &dcFlags,
+			},
+			cli.BoolFlag{
+				Name:  "verbose",
+				Usage: "Display verbose result status.",
+			},
+			cli.BoolFlag{
+				Name:  "json",
+				Usage: "Return status in JSON format.",
+			},
+			cli.BoolFlag{
+				Name:  "complete",
+				Usage: "Wait for change completion.",
+			},
+			cli.IntFlag{
+				Name:  "timeout",
+				Usage: "Change completion wait timeout in seconds.",
+				Value: 300,
+			},
+			cli.BoolFlag{
+				Name:  "dryrun",
+				Usage: "Return planned server replacement change(s).",
+			},
+			cli.StringFlag{
+				Name:  "policy",
+				Usage: "Policy file evaluated against planned changes. Defaults to ~/.akamai-gtm/policy.json if present.",
+			},
+			cli.StringFlag{
+				Name:  "override-policy",
+				Usage: "Apply change(s) despite policy violations. The specified reason is recorded in ~/.akamai-gtm/audit.log.",
+			},
+			cli.BoolFlag{
+				Name:  "yes",
+				Usage: "Apply change(s) without confirmation. Required when stdin is not a terminal.",
+			},
+		},
+		BashComplete: cmdAutoComplete,
+	})
+
+	commands = append(commands, cli.Command{
+		Name:        "search",
+		Description: "Search domain(s) for references to an IP address, CNAME, datacenter, liveness test object or other value",
+		ArgsUsage:   "<domain> <term>",
+		Action:      cmdSearch,
+		Flags: []cli.Flag{
+			cli.BoolFlag{
+				Name:  "all-domains",
+				Usage: "Search all domains. Domain argument is omitted.",
+			},
+			cli.BoolFlag{
+				Name:  "exact",
+				Usage: "Match term exactly rather than as a case insensitive substring.",
+			},
+			cli.BoolFlag{
+				Name:  "verbose",
+				Usage: "Display verbose status.",
+			},
+			cli.BoolFlag{
+				Name:  "json",
+				Usage: "Return search results in JSON format.",
+			},
+			cli.BoolFlag{
+				Name:  "no-cache",
+				Usage: "Retrieve domain configuration from GTM rather than the local cache.",
+			},
+		},
+		BashComplete: cmdAutoComplete,
+	})
+
+	commands = append(commands, cli.Command{
+		Name:        "lint",
+		Description: "Check domain configuration for common misconfigurations",
+		ArgsUsage:   "<domain>",
+		Action:      cmdLint,
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "file",
+				Usage: "Check domain exported in JSON format to specified file instead of retrieving domain.",
+			},
+			cli.StringSliceFlag{
+				Name:  "suppress",
+				Usage: "Suppress findings of rule, or rule:object for a single property, datacenter or liveness test. Multiple suppress flags may be specified.",
+			},
+			cli.StringFlag{
+				Name:  "fail-on",
+				Usage: "Exit with failure status if findings of specified severity or higher exist. Acceptable values: info, warning, error.",
+				Value: severityError,
+			},
+			cli.BoolFlag{
+				Name:  "list-rules",
+				Usage: "List available lint rules.",
+			},
+			cli.BoolFlag{
+				Name:  "verbose",
+				Usage: "Display verbose status.",
+			},
+			cli.BoolFlag{
+				Name:  "json",
+				Usage: "Return lint results in JSON format.",
+			},
+			cli.BoolFlag{
+				Name:  "no-cache",
+				Usage: "Retrieve domain configuration from GTM rather than the local cache.",
+			},
+		},
+		BashComplete: cmdAutoComplete,
+	})
+
+	staticRRSetChangeFlags := []cli.Flag{
+		cli.StringFlag{
+			Name:  "type",
+			Usage: "Record type of static RR set, e.g. TXT.",
+		},
+		cli.IntFlag{
+			Name:  "ttl",
+			Usage: "Record set TTL in seconds. Defaults to property static TTL or 300 on add.",
+		},
+		cli.StringSliceFlag{
+			Name:  "rdata",
+			Usage: "Record data value. Multiple rdata flags may be specified.",
+		},
+		cli.BoolFlag{
+			Name:  "verbose",
+			Usage: "Display verbose result status.",
+		},
+		cli.BoolFlag{
+			Name:  "json",
+			Usage: "Return status in JSON format.",
+		},
+		cli.BoolFlag{
+			Name:  "complete",
+			Usage: "Wait for change completion.",
+		},
+		cli.IntFlag{
+			Name:  "timeout",
+			Usage: "Change completion wait timeout in seconds.",
+			Value: 300,
+		},
+		cli.BoolFlag{
+			Name:  "dryrun",
+			Usage: "Return planned static RR set change(s).",
+		},
+		cli.StringFlag{
+			Name:  "policy",
+			Usage: "Policy file evaluated against planned changes. Defaults to ~/.akamai-gtm/policy.json if present.",
+		},
+		cli.StringFlag{
+			Name:  "override-policy",
+			Usage: "Apply change(s) despite policy violations. The specified reason is recorded in ~/.akamai-gtm/audit.log.",
+		},
+		cli.BoolFlag{
+			Name:  "yes",
+			Usage: "Apply change(s) without confirmation. Required when stdin is not a terminal.",
+		},
+	}
+
+	commands = append(commands, cli.Command{
+		Name:        "static-rrset",
+		Description: "Manage property static RR sets",
+		ArgsUsage:   "<domain> <property>",
+		Subcommands: []cli.Command{
+			{
+				Name:        "list",
+				Description: "List property static RR sets",
+				ArgsUsage:   "<domain> <property>",
+				Action:      cmdStaticRRSetList,
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "json",
+						Usage: "Return static RR sets in JSON format.",
+					},
+				},
+				BashComplete: cmdAutoComplete,
+			},
+			{
+				Name:         "add",
+				Description:  "Add static RR set or rdata values to property",
+				ArgsUsage:    "<domain> <property>",
+				Action:       cmdStaticRRSetModify,
+				Flags:        staticRRSetChangeFlags,
+				BashComplete: cmdAutoComplete,
+			},
+			{
+				Name:         "update",
+				Description:  "Update rdata and/or ttl of property static RR set",
+				ArgsUsage:    "<domain> <property>",
+				Action:       cmdStaticRRSetModify,
+				Flags:        staticRRSetChangeFlags,
+				BashComplete: cmdAutoComplete,
+			},
+			{
+				Name:         "remove",
+				Description:  "Remove static RR set or rdata values from property",
+				ArgsUsage:    "<domain> <property>",
+				Action:       cmdStaticRRSetModify,
+				Flags:        staticRRSetChangeFlags,
+				BashComplete: cmdAutoComplete,
+			},
+		},
+		BashComplete: cmdAutoComplete,
 	})
 
 	commands = append(commands, cli.Command{
@@ -251,12 +613,20 @@ var commandLocator akamai.CommandLocator = func() ([]cli.Command, error) {
 		Flags: []cli.Flag{
 			cli.GenericFlag{
 				Name:  "datacenter",
-				Usage: "Report status of specified datacenter target by id or nickname.",
+				Usage: "Report status of specified datacenter target by id, nickname, @group or attribute=value.",
 				Value: &dcFlags,
 			},
-			cli.StringFlag{
+			cli.StringSliceFlag{
 				Name:  "property",
-				Usage: "Report status of specified property.",
+				Usage: "Report status of specified property. Multiple properties may be specified.",
+			},
+			cli.StringSliceFlag{
+				Name:  "property-regex",
+				Usage: "Report status of properties whose name matches the specified regular expression. Multiple expressions may be specified.",
+			},
+			cli.BoolFlag{
+				Name:  "all-properties",
+				Usage: "Report status of all domain properties.",
 			},
 			cli.BoolFlag{
 				Name:  "verbose",
@@ -266,10 +636,337 @@ var commandLocator akamai.CommandLocator = func() ([]cli.Command, error) {
 				Name:  "json",
 				Usage: "Return status in JSON format.",
 			},
+			cli.BoolFlag{
+				Name:  "no-cache",
+				Usage: "Retrieve datacenter list from GTM rather than the local cache.",
+			},
+			cli.StringFlag{
+				Name:  "period",
+				Usage: "Length of the reporting period, e.g. 30m, 6h or 2d. Default is 15m.",
+			},
+			cli.StringFlag{
+				Name:  "start",
+				Usage: "Start of the reporting period. RFC3339 time, relative time, e.g. -24h, today or yesterday.",
+			},
+			cli.StringFlag{
+				Name:  "end",
+				Usage: "End of the reporting period. RFC3339 time, relative time, e.g. -1h, now, today or yesterday. Default is the latest available report data.",
+			},
+			cli.StringFlag{
+				Name:  "timezone",
+				Usage: "Timezone of displayed timestamps and of today and yesterday, e.g. America/New_York or Local. Default is UTC.",
+			},
+			cli.GenericFlag{
+				Name:  "watch",
+				Usage: "Refresh status every interval, e.g. --watch=30s, highlighting changes. Default interval is 10s.",
+				Value: &qsWatch,
+			},
+			cli.GenericFlag{
+				Name:  "chart",
+				Usage: "Chart requests by datacenter as sparklines, or as a stacked chart with --chart=stacked.",
+				Value: &chartFlags,
+			},
+		},
+		BashComplete: cmdAutoComplete,
+	})
+
+	commands = append(commands, cli.Command{
+		Name:        "health",
+		Description: "Display property by datacenter IP health of domain, worst first",
+		ArgsUsage:   "<domain>",
+		Action:      cmdHealth,
+		Flags: []cli.Flag{
+			cli.BoolFlag{
+				Name:  "verbose",
+				Usage: "Display verbose status.",
+			},
+			cli.BoolFlag{
+				Name:  "json",
+				Usage: "Return health in JSON format.",
+			},
+			cli.BoolFlag{
+				Name:  "no-cache",
+				Usage: "Retrieve domain configuration from GTM rather than the local cache.",
+			},
+		},
+		BashComplete: cmdAutoComplete,
+	})
+
+	commands = append(commands, cli.Command{
+		Name:        "traffic-report",
+		Description: "Report property or datacenter traffic by hour or day",
+		ArgsUsage:   "<domain>",
+		Action:      cmdTrafficReport,
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "property",
+				Usage: "Report traffic of specified property.",
+			},
+			cli.GenericFlag{
+				Name:  "datacenter",
+				Usage: "Report traffic of specified datacenter by id, nickname, @group or attribute=value. Multiple datacenters may be specified.",
+				Value: &dcFlags,
+			},
+			cli.StringFlag{
+				Name:  "aggregate",
+				Usage: "Aggregate requests by hour or day.",
+				Value: "hour",
+			},
+			cli.StringFlag{
+				Name:  "period",
+				Usage: "Length of the reporting period, e.g. 12h, 7d or 1w. Default is 1d.",
+			},
+			cli.StringFlag{
+				Name:  "start",
+				Usage: "Start of the reporting period. RFC3339 time, relative time, e.g. -7d, today or yesterday.",
+			},
+			cli.StringFlag{
+				Name:  "end",
+				Usage: "End of the reporting period. RFC3339 time, relative time, e.g. -1h, now, today or yesterday. Default is the latest available report data.",
+			},
+			cli.StringFlag{
+				Name:  "timezone",
+				Usage: "Timezone of hour and day boundaries and displayed timestamps, e.g. America/New_York or Local. Default is UTC.",
+			},
+			cli.StringFlag{
+				Name:  "compare",
+				Usage: "Compare with the period the specified duration earlier, e.g. 1w for week-over-week.",
+			},
+			cli.GenericFlag{
+				Name:  "chart",
+				Usage: "Chart requests by datacenter as sparklines, or as a stacked chart with --chart=stacked.",
+				Value: &chartFlags,
+			},
+			cli.BoolFlag{
+				Name:  "verbose",
+				Usage: "Display verbose status.",
+			},
+			cli.BoolFlag{
+				Name:  "json",
+				Usage: "Return traffic report in JSON format.",
+			},
+			cli.BoolFlag{
+				Name:  "csv",
+				Usage: "Return traffic report in CSV format, one row per hour or day and datacenter.",
+			},
+			cli.BoolFlag{
+				Name:  "no-cache",
+				Usage: "Retrieve domain configuration from GTM rather than the local cache.",
+			},
+		},
+		BashComplete: cmdAutoComplete,
+	})
+
+	commands = append(commands, cli.Command{
+		Name:        "anomalies",
+		Description: "Detect property and datacenter traffic deviating from the same period on previous days",
+		ArgsUsage:   "<domain>",
+		Action:      cmdAnomalies,
+		Flags: []cli.Flag{
+			cli.StringSliceFlag{
+				Name:  "property",
+				Usage: "Detect anomalies of specified property. Multiple properties may be specified. Default is all properties.",
+			},
+			cli.StringSliceFlag{
+				Name:  "property-regex",
+				Usage: "Detect anomalies of properties matching the specified regular expression. Multiple expressions may be specified.",
+			},
+			cli.GenericFlag{
+				Name:  "datacenter",
+				Usage: "Detect anomalies of specified datacenter traffic across properties by id, nickname, @group or attribute=value. Multiple datacenters may be specified.",
+				Value: &dcFlags,
+			},
+			cli.StringFlag{
+				Name:  "period",
+				Usage: "Length of the period scored, at most 1d, e.g. 30m or 6h. Default is 1h.",
+			},
+			cli.StringFlag{
+				Name:  "end",
+				Usage: "End of the period scored. RFC3339 time, relative time, e.g. -1h, now, today or yesterday. Default is the latest available report data.",
+			},
+			cli.StringFlag{
+				Name:  "timezone",
+				Usage: "Timezone of displayed timestamps, e.g. America/New_York or Local. Default is UTC.",
+			},
+			cli.IntFlag{
+				Name:  "baseline-days",
+				Usage: "Number of previous days whose same period forms the baseline.",
+				Value: defaultBaselineDays,
+			},
+			cli.Float64Flag{
+				Name:  "threshold",
+				Usage: "Modified z-score beyond which requests are anomalous.",
+				Value: defaultAnomalyThreshold,
+			},
+			cli.Int64Flag{
+				Name:  "min-requests",
+				Usage: "Ignore series whose baseline median and current requests are both fewer.",
+				Value: defaultAnomalyMinimum,
+			},
+			cli.BoolFlag{
+				Name:  "drops-only",
+				Usage: "Report drops in requests only.",
+			},
+			cli.BoolFlag{
+				Name:  "verbose",
+				Usage: "Display verbose status.",
+			},
+			cli.BoolFlag{
+				Name:  "json",
+				Usage: "Return anomalies in JSON format.",
+			},
+			cli.BoolFlag{
+				Name:  "no-cache",
+				Usage: "Retrieve domain configuration from GTM rather than the local cache.",
+			},
+		},
+		BashComplete: cmdAutoComplete,
+	})
+
+	commands = append(commands, cli.Command{
+		Name:        "analyze-distribution",
+		Description: "Compare weighted property target weight shares with observed traffic",
+		ArgsUsage:   "<domain> <property>",
+		Action:      cmdAnalyzeDistribution,
+		Flags: []cli.Flag{
+			cli.Float64Flag{
+				Name:  "tolerance",
+				Usage: "Maximum deviation of observed from expected share, in percentage points.",
+				Value: defaultDistributionTolerance,
+			},
+			cli.StringFlag{
+				Name:  "period",
+				Usage: "Length of the analysis period, e.g. 30m, 6h or 1d. Default is 1h.",
+			},
+			cli.StringFlag{
+				Name:  "start",
+				Usage: "Start of the analysis period. RFC3339 time, relative time, e.g. -6h, today or yesterday.",
+			},
+			cli.StringFlag{
+				Name:  "end",
+				Usage: "End of the analysis period. RFC3339 time, relative time, e.g. -1h, now, today or yesterday. Default is the latest available report data.",
+			},
+			cli.StringFlag{
+				Name:  "timezone",
+				Usage: "Timezone of displayed timestamps, e.g. America/New_York or Local. Default is UTC.",
+			},
+			cli.BoolFlag{
+				Name:  "verbose",
+				Usage: "Display verbose status.",
+			},
+			cli.BoolFlag{
+				Name:  "json",
+				Usage: "Return distribution analysis in JSON format.",
+			},
+		},
+		BashComplete: cmdAutoComplete,
+	})
+
+	commands = append(commands, cli.Command{
+		Name:        "check",
+		Description: "Check domain status against thresholds as a Nagios compatible plugin",
+		ArgsUsage:   "<domain>",
+		Action:      cmdCheck,
+		Flags: []cli.Flag{
+			cli.StringSliceFlag{
+				Name:  "property",
+				Usage: "Check specified property. Multiple properties may be specified. Default is all properties.",
+			},
+			cli.StringFlag{
+				Name:  "alive-warning",
+				Usage: "Warn if an enabled datacenter has fewer alive IPs.",
+			},
+			cli.StringFlag{
+				Name:  "alive-critical",
+				Usage: "Critical if an enabled datacenter has fewer alive IPs.",
+			},
+			cli.StringFlag{
+				Name:  "share-warning",
+				Usage: "Warn if a datacenter serves more than the specified percent of property requests.",
+			},
+			cli.StringFlag{
+				Name:  "share-critical",
+				Usage: "Critical if a datacenter serves more than the specified percent of property requests.",
+			},
+			cli.StringFlag{
+				Name:  "enabled-warning",
+				Usage: "Warn if a property has fewer enabled traffic targets.",
+			},
+			cli.StringFlag{
+				Name:  "enabled-critical",
+				Usage: "Critical if a property has fewer enabled traffic targets.",
+			},
+			cli.StringFlag{
+				Name:  "pending-warning",
+				Usage: "Warn if propagation has been PENDING for more minutes.",
+			},
+			cli.StringFlag{
+				Name:  "pending-critical",
+				Usage: "Critical if propagation has been PENDING for more minutes.",
+			},
+			cli.StringFlag{
+				Name:  "period",
+				Usage: "Period of requests checked by share thresholds, e.g. 30m or 1h. Default is 15m.",
+			},
+		},
+		BashComplete: cmdAutoComplete,
+	})
+
+	commands = append(commands, cli.Command{
+		Name:        "serve-metrics",
+		Description: "Serve domain status and traffic metrics in Prometheus format",
+		Action:      cmdServeMetrics,
+		Flags: []cli.Flag{
+			cli.StringFlag{
+				Name:  "listen",
+				Usage: "Address to serve /metrics on.",
+				Value: defaultMetricsListen,
+			},
+			cli.StringSliceFlag{
+				Name:  "domain",
+				Usage: "Collect metrics of specified domain. Multiple domains may be specified.",
+			},
+			cli.StringFlag{
+				Name:  "interval",
+				Usage: "Collection interval, e.g. 30s or 5m.",
+				Value: defaultMetricsInterval,
+			},
+			cli.BoolFlag{
+				Name:  "verbose",
+				Usage: "Log collection errors.",
+			},
+		},
+		BashComplete: cmdAutoComplete,
+	})
+
+	commands = append(commands, cli.Command{
+		Name:        "cache",
+		Description: "Manage the local domain and datacenter cache",
+		Subcommands: []cli.Command{
+			{
+				Name:        "clear",
+				Description: "Remove cached data of the .edgerc section in use",
+				Action:      cmdCacheClear,
+				Flags: []cli.Flag{
+					cli.BoolFlag{
+						Name:  "all",
+						Usage: "Remove cached data of all .edgerc sections.",
+					},
+				},
+				BashComplete: akamai.DefaultAutoComplete,
+			},
 		},
 		BashComplete: akamai.DefaultAutoComplete,
 	})
 
+	commands = append(commands, cli.Command{
+		Name:         "completion",
+		Description:  "Generate shell completion script for bash, zsh or fish",
+		ArgsUsage:    "<bash|zsh|fish>",
+		Action:       cmdCompletion,
+		BashComplete: completeShells,
+	})
+
 	commands = append(commands,
 		cli.Command{
 			Name:        "list",
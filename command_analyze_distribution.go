@@ -0,0 +1,305 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/reportsgtm-v1"
+	akamai "github.com/akamai/cli-common-golang"
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const defaultDistributionPeriod = time.Hour
+
+// default maximum deviation of observed from expected share, in percentage points
+const defaultDistributionTolerance float64 = 5
+
+// requests below which deviations may be noise
+const lowRequestVolume int64 = 1000
+
+// DistributionAnalysis compares the configured weight share of each traffic target with its observed share of requests
+type DistributionAnalysis struct {
+	Domain        string
+	Property      string
+	PropertyType  string
+	PeriodStart   string
+	PeriodEnd     string
+	TotalRequests int64
+	Tolerance     float64
+	Deviations    int
+	Notes         []string `json:",omitempty"`
+	Targets       []*TargetDistribution
+}
+
+// TargetDistribution is the expected and observed share of a traffic target
+type TargetDistribution struct {
+	DatacenterId  int
+	Nickname      string
+	Enabled       bool
+	Weight        float64
+	ExpectedShare float64
+	ObservedShare float64
+	Deviation     float64
+	Requests      int64
+	IPs           int
+	Alive         int
+	HandedOut     int
+	Deviates      bool
+	Causes        []string `json:",omitempty"`
+}
+
+// roundShare rounds a percentage to two decimals
+func roundShare(share float64) float64 {
+
+	return math.Round(share*100) / 100
+}
+
+// analyzeDistribution compares weight and observed shares of the property status targets and explains deviations beyond tolerance
+func analyzeDistribution(prop *configgtm.Property, propStat *PropertyStatus, tolerance float64) *DistributionAnalysis {
+
+	analysis := &DistributionAnalysis{Domain: propStat.Domain, Property: prop.Name, PropertyType: prop.Type, PeriodStart: propStat.PeriodStart, PeriodEnd: propStat.PeriodEnd, Tolerance: tolerance}
+	var totalWeight float64
+	for _, dc := range propStat.StatusSummary.PropertyDCStatus {
+		// same basis as DCPropertyUsage
+		analysis.TotalRequests += dc.DCTotalPeriodRequests
+		if dc.DCEnabled {
+			totalWeight += dc.DCWeight
+		}
+	}
+	for _, dc := range propStat.StatusSummary.PropertyDCStatus {
+		dcHealth := summarizeDatacenterHealth(&dc.IpStatPerPropDRow)
+		target := &TargetDistribution{DatacenterId: dc.DatacenterId, Nickname: dc.Nickname, Enabled: dc.DCEnabled, Weight: dc.DCWeight, Requests: dc.DCTotalPeriodRequests, IPs: dcHealth.IPs, Alive: dcHealth.Alive, HandedOut: dcHealth.HandedOut}
+		if dc.DCEnabled && totalWeight > 0 {
+			target.ExpectedShare = roundShare(dc.DCWeight / totalWeight * 100)
+		}
+		if analysis.TotalRequests > 0 {
+			target.ObservedShare = roundShare(float64(dc.DCTotalPeriodRequests) / float64(analysis.TotalRequests) * 100)
+		}
+		target.Deviation = roundShare(target.ObservedShare - target.ExpectedShare)
+		target.Deviates = analysis.TotalRequests > 0 && math.Abs(target.Deviation) > tolerance
+		if target.Deviates {
+			analysis.Deviations++
+		}
+		analysis.Targets = append(analysis.Targets, target)
+	}
+	sort.Slice(analysis.Targets, func(i, j int) bool { return analysis.Targets[i].DatacenterId < analysis.Targets[j].DatacenterId })
+
+	// targets unable to take their share shift it to the others
+	var unavailable []string
+	for _, target := range analysis.Targets {
+		if target.Enabled && target.Weight > 0 && (target.Alive == 0 || target.HandedOut == 0) {
+			unavailable = append(unavailable, distributionLabel(target))
+		}
+	}
+	for _, target := range analysis.Targets {
+		if target.Deviates {
+			target.Causes = deviationCauses(target, unavailable)
+		}
+	}
+
+	if totalWeight == 0 {
+		analysis.Notes = append(analysis.Notes, "No enabled traffic target has weight")
+	}
+	if analysis.TotalRequests == 0 {
+		analysis.Notes = append(analysis.Notes, "No requests in period")
+	} else if analysis.TotalRequests < lowRequestVolume {
+		analysis.Notes = append(analysis.Notes, fmt.Sprintf("Only %d requests in period. Deviations may not be significant", analysis.TotalRequests))
+	}
+	if strings.Contains(prop.Type, "load-feedback") {
+		analysis.Notes = append(analysis.Notes, "Load feedback adjusts weights to reported load. Deviations from configured weights are expected")
+	}
+	return analysis
+}
+
+// deviationCauses explains the likely causes of a target's deviation from its weight share
+func deviationCauses(target *TargetDistribution, unavailable []string) []string {
+
+	var causes []string
+	if target.Deviation < 0 {
+		switch {
+		case target.IPs == 0:
+			causes = append(causes, "no IP status reported")
+		case target.Alive == 0:
+			causes = append(causes, "target not alive")
+		case target.HandedOut == 0:
+			causes = append(causes, "target not handed out")
+		case target.Alive < target.IPs:
+			causes = append(causes, fmt.Sprintf("%d of %d IPs not alive", target.IPs-target.Alive, target.IPs))
+		}
+	} else {
+		if !target.Enabled {
+			causes = append(causes, "target disabled but received requests. Enabled during period or cached responses")
+		}
+		var others []string
+		for _, label := range unavailable {
+			if label != distributionLabel(target) {
+				others = append(others, label)
+			}
+		}
+		if len(others) > 0 {
+			causes = append(causes, "receiving share of unavailable "+strings.Join(others, ", "))
+		}
+	}
+	if len(causes) == 0 {
+		causes = append(causes, "no target issue reported. Resolver caching or uneven resolver load may skew distribution")
+	}
+	return causes
+}
+
+// distributionLabel returns the target datacenter nickname or id
+func distributionLabel(target *TargetDistribution) string {
+
+	if target.Nickname != "" {
+		return target.Nickname
+	}
+	return strconv.Itoa(target.DatacenterId)
+}
+
+// worker function for analyze-distribution
+func cmdAnalyzeDistribution(c *cli.Context) error {
+
+	config, err := akamai.GetEdgegridConfig(c)
+	if err != nil {
+		return err
+	}
+	configgtm.Init(config)
+	reportsgtm.Init(config)
+
+	if c.NArg() < 2 {
+		cli.ShowCommandHelp(c, c.Command.Name)
+		return cli.NewExitError(color.RedString("domain and property are required"), 1)
+	}
+	if c.IsSet("verbose") {
+		verboseStatus = true
+	}
+	domainName = c.Args().Get(0)
+	propName := c.Args().Get(1)
+	tolerance := c.Float64("tolerance")
+	if tolerance < 0 || tolerance > 100 {
+		return cli.NewExitError(color.RedString("tolerance must be between 0 and 100"), 1)
+	}
+	qsPeriod, err = parseReportPeriod(c, defaultDistributionPeriod)
+	if err != nil {
+		return cli.NewExitError(color.RedString(err.Error()), 1)
+	}
+
+	qsDomain, err = configgtm.GetDomain(domainName)
+	if err != nil {
+		return cli.NewExitError(color.RedString("Domain "+domainName+" not found "), 1)
+	}
+	var prop *configgtm.Property
+	var names []string
+	for _, p := range qsDomain.Properties {
+		names = append(names, p.Name)
+		if p.Name == propName {
+			prop = p
+		}
+	}
+	if prop == nil {
+		return cli.NewExitError(color.RedString(fmt.Sprintf("Property %s not found in domain %s%s", propName, domainName, suggestionText(closestMatches(names, propName)))), 1)
+	}
+	if !strings.Contains(prop.Type, "weighted") {
+		return cli.NewExitError(color.RedString(fmt.Sprintf("Property %s is of type %s. Distribution analysis requires a weighted property", propName, prop.Type)), 1)
+	}
+
+	if !c.IsSet("json") {
+		akamai.StartSpinner("Analyzing traffic distribution ", "")
+	}
+	pstart, pend, err := calcPeriodStartandEnd("property", qsPeriod)
+	if err != nil {
+		if !c.IsSet("json") {
+			akamai.StopSpinnerFail()
+		}
+		return cli.NewExitError(color.RedString(err.Error()), 1)
+	}
+	propStat, err := gatherPropertyStatus(propName, pstart, pend)
+	if err != nil {
+		if !c.IsSet("json") {
+			akamai.StopSpinnerFail()
+		}
+		return cli.NewExitError(color.RedString(statusErrorText(err)), 1)
+	}
+	analysis := analyzeDistribution(prop, propStat, tolerance)
+	if !c.IsSet("json") {
+		akamai.StopSpinnerOk()
+	}
+
+	if c.IsSet("json") && c.Bool("json") {
+		json, err := json.MarshalIndent(analysis, "", "  ")
+		if err != nil {
+			return cli.NewExitError(color.RedString("Unable to display distribution analysis"), 1)
+		}
+		fmt.Fprintln(c.App.Writer, string(json))
+	} else {
+		fmt.Fprintln(c.App.Writer, renderDistributionTable(analysis))
+	}
+
+	return nil
+}
+
+// Pretty print expected and observed target shares
+func renderDistributionTable(analysis *DistributionAnalysis) string {
+
+	var outString string
+	outString += fmt.Sprintln(" ")
+	outString += fmt.Sprintln(fmt.Sprintf("Domain: %s", analysis.Domain))
+	outString += fmt.Sprintln(fmt.Sprintf("Property: %s (%s)", analysis.Property, analysis.PropertyType))
+	outString += fmt.Sprintln(fmt.Sprintf("Period: %s .. %s", qsPeriod.displayTime(analysis.PeriodStart), qsPeriod.displayTime(analysis.PeriodEnd)))
+	outString += fmt.Sprintln(fmt.Sprintf("Requests: %d, Tolerance: %s points, Deviations: %d", analysis.TotalRequests, strconv.FormatFloat(analysis.Tolerance, 'f', -1, 64), analysis.Deviations))
+	outString += fmt.Sprintln(" ")
+	tableString := &strings.Builder{}
+	table := tablewriter.NewWriter(tableString)
+
+	table.SetHeader([]string{"Datacenter", "Nickname", "Enabled", "Weight", "Expected", "Observed", "Deviation", "Alive", "Handed Out", "Likely Cause"})
+	table.SetReflowDuringAutoWrap(false)
+	table.SetCenterSeparator(" ")
+	table.SetColumnSeparator(" ")
+	table.SetRowSeparator(" ")
+	table.SetBorder(false)
+	table.SetAutoWrapText(false)
+	table.SetColumnAlignment([]int{tablewriter.ALIGN_CENTER, tablewriter.ALIGN_LEFT, tablewriter.ALIGN_CENTER, tablewriter.ALIGN_RIGHT, tablewriter.ALIGN_RIGHT, tablewriter.ALIGN_RIGHT, tablewriter.ALIGN_RIGHT, tablewriter.ALIGN_CENTER, tablewriter.ALIGN_CENTER, tablewriter.ALIGN_LEFT})
+	table.SetAlignment(tablewriter.ALIGN_CENTER)
+
+	if len(analysis.Targets) == 0 {
+		table.Append([]string{"No traffic targets", " ", " ", " ", " ", " ", " ", " ", " ", " "})
+	}
+	for _, target := range analysis.Targets {
+		deviation := fmt.Sprintf("%+.2f%%", target.Deviation)
+		cause := " "
+		if target.Deviates {
+			deviation = color.RedString(deviation)
+			cause = strings.Join(target.Causes, "; ")
+		}
+		table.Append([]string{strconv.Itoa(target.DatacenterId), target.Nickname, strconv.FormatBool(target.Enabled), strconv.FormatFloat(target.Weight, 'f', 1, 64),
+			fmt.Sprintf("%.2f%%", target.ExpectedShare), fmt.Sprintf("%.2f%%", target.ObservedShare), deviation,
+			fmt.Sprintf("%d/%d", target.Alive, target.IPs), strconv.Itoa(target.HandedOut), cause})
+	}
+	table.Render()
+	outString += fmt.Sprintln(tableString.String())
+
+	for _, note := range analysis.Notes {
+		outString += fmt.Sprintln(color.YellowString("Note: " + note))
+	}
+	return outString
+}
@@ -0,0 +1,120 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/reportsgtm-v1"
+	"reflect"
+	"testing"
+)
+
+// testDCStatus returns the status of a datacenter target with one IP per alive value. IPs are handed out if alive.
+func testDCStatus(dcId int, nickname string, enabled bool, weight float64, requests int64, alive ...bool) *PropertyDCStatus {
+
+	dc := &PropertyDCStatus{DCEnabled: enabled, DCWeight: weight, DCTotalPeriodRequests: requests}
+	dc.DatacenterId = dcId
+	dc.Nickname = nickname
+	for _, a := range alive {
+		dc.IPs = append(dc.IPs, &reportsgtm.IpStatIp{Alive: a, HandedOut: a})
+	}
+	return dc
+}
+
+func TestAnalyzeDistribution(t *testing.T) {
+
+	type targetWant struct {
+		expected  float64
+		observed  float64
+		deviation float64
+		deviates  bool
+		causes    []string
+	}
+	tests := []struct {
+		name       string
+		propType   string
+		dcs        []*PropertyDCStatus
+		tolerance  float64
+		deviations int
+		targets    []targetWant
+		notes      []string
+	}{
+		{"balanced", "weighted-round-robin", []*PropertyDCStatus{
+			testDCStatus(3132, "west", true, 1, 5000, true),
+			testDCStatus(3131, "east", true, 1, 5000, true),
+		}, 5, 0, []targetWant{{50, 50, 0, false, nil}, {50, 50, 0, false, nil}}, nil},
+		{"within tolerance", "weighted-round-robin", []*PropertyDCStatus{
+			testDCStatus(3131, "east", true, 3, 7900, true),
+			testDCStatus(3132, "west", true, 1, 2100, true),
+		}, 5, 0, []targetWant{{75, 79, 4, false, nil}, {25, 21, -4, false, nil}}, nil},
+		{"unavailable target shifts share", "weighted-round-robin", []*PropertyDCStatus{
+			testDCStatus(3131, "east", true, 1, 0, false),
+			testDCStatus(3132, "west", true, 1, 10000, true),
+		}, 5, 2, []targetWant{
+			{50, 0, -50, true, []string{"target not alive"}},
+			{50, 100, 50, true, []string{"receiving share of unavailable east"}},
+		}, nil},
+		{"partially alive target", "weighted-round-robin", []*PropertyDCStatus{
+			testDCStatus(3131, "", true, 1, 3000, true, false),
+			testDCStatus(3132, "west", true, 1, 7000, true),
+		}, 5, 2, []targetWant{
+			{50, 30, -20, true, []string{"1 of 2 IPs not alive"}},
+			{50, 70, 20, true, []string{"no target issue reported. Resolver caching or uneven resolver load may skew distribution"}},
+		}, nil},
+		{"disabled target receiving requests", "weighted-round-robin", []*PropertyDCStatus{
+			testDCStatus(3131, "east", true, 1, 8000, true),
+			testDCStatus(3132, "west", false, 1, 2000, true),
+		}, 5, 2, []targetWant{
+			{100, 80, -20, true, []string{"no target issue reported. Resolver caching or uneven resolver load may skew distribution"}},
+			{0, 20, 20, true, []string{"target disabled but received requests. Enabled during period or cached responses"}},
+		}, nil},
+		{"no requests", "weighted-round-robin", []*PropertyDCStatus{
+			testDCStatus(3131, "east", true, 1, 0, true),
+		}, 5, 0, []targetWant{{100, 0, -100, false, nil}}, []string{"No requests in period"}},
+		{"low volume without weight", "weighted-hashed", []*PropertyDCStatus{
+			testDCStatus(3131, "east", true, 0, 500, true),
+		}, 5, 1, []targetWant{{0, 100, 100, true, []string{"no target issue reported. Resolver caching or uneven resolver load may skew distribution"}}},
+			[]string{"No enabled traffic target has weight", "Only 500 requests in period. Deviations may not be significant"}},
+		{"load feedback", "weighted-round-robin-load-feedback", []*PropertyDCStatus{
+			testDCStatus(3131, "east", true, 1, 5000, true),
+		}, 5, 0, []targetWant{{100, 100, 0, false, nil}}, []string{"Load feedback adjusts weights to reported load. Deviations from configured weights are expected"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prop := &configgtm.Property{Name: "www", Type: tt.propType}
+			propStat := &PropertyStatus{Domain: "example.akadns.net", StatusSummary: &PropertyStatusSummary{PropertyDCStatus: tt.dcs}}
+			analysis := analyzeDistribution(prop, propStat, tt.tolerance)
+			if analysis.Deviations != tt.deviations {
+				t.Errorf("deviations %d, want %d", analysis.Deviations, tt.deviations)
+			}
+			if !reflect.DeepEqual(analysis.Notes, tt.notes) {
+				t.Errorf("notes %q, want %q", analysis.Notes, tt.notes)
+			}
+			if len(analysis.Targets) != len(tt.targets) {
+				t.Fatalf("%d targets, want %d", len(analysis.Targets), len(tt.targets))
+			}
+			for i, want := range tt.targets {
+				target := analysis.Targets[i]
+				if i > 0 && target.DatacenterId < analysis.Targets[i-1].DatacenterId {
+					t.Errorf("targets not sorted by datacenter id")
+				}
+				got := targetWant{target.ExpectedShare, target.ObservedShare, target.Deviation, target.Deviates, target.Causes}
+				if !reflect.DeepEqual(got, want) {
+					t.Errorf("datacenter %d = %+v, want %+v", target.DatacenterId, got, want)
+				}
+			}
+		})
+	}
+}
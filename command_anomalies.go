@@ -0,0 +1,403 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/reportsgtm-v1"
+	akamai "github.com/akamai/cli-common-golang"
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const defaultAnomalyPeriod = time.Hour
+
+const (
+	defaultBaselineDays     int     = 7
+	minBaselineDays         int     = 3
+	defaultAnomalyThreshold float64 = 3.5
+	defaultAnomalyMinimum   int64   = 100
+)
+
+const oneDay = 24 * time.Hour
+
+// AnomalyReport lists the traffic series whose requests deviate from their baseline
+type AnomalyReport struct {
+	Domain       string
+	PeriodStart  string
+	PeriodEnd    string
+	BaselineDays int
+	Threshold    float64
+	MinRequests  int64
+	Evaluated    int
+	Anomalies    []*TrafficAnomaly
+	Errors       []string `json:",omitempty"`
+}
+
+// TrafficAnomaly is the requests of a property datacenter, or of a datacenter across properties, compared with its baseline.
+// Score is omitted if the baseline is all zero.
+type TrafficAnomaly struct {
+	Property       string `json:",omitempty"`
+	DatacenterId   int
+	Nickname       string
+	Direction      string
+	Requests       int64
+	Baseline       []int64
+	BaselineMedian float64
+	BaselineMAD    float64
+	Change         *float64 `json:",omitempty"`
+	Score          *float64 `json:",omitempty"`
+}
+
+// anomalySeries is the requests of a series in the current period and the same period on previous days
+type anomalySeries struct {
+	property     string
+	datacenterId int
+	nickname     string
+	windows      []int64 // index 0 is the current period, index k is k days earlier
+}
+
+// median returns the median of values
+func median(values []float64) float64 {
+
+	if len(values) == 0 {
+		return 0
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
+
+// robustScore returns the baseline median and median absolute deviation and the modified z-score of current.
+// If the baseline MAD is zero the mean absolute deviation is used. ok is false if the baseline is all zero.
+func robustScore(current int64, baseline []int64) (med float64, mad float64, score float64, ok bool) {
+
+	values := make([]float64, len(baseline))
+	for i, v := range baseline {
+		values[i] = float64(v)
+	}
+	med = median(values)
+	deviations := make([]float64, len(values))
+	var meanAD float64
+	for i, v := range values {
+		deviations[i] = math.Abs(v - med)
+		meanAD += deviations[i]
+	}
+	meanAD /= float64(len(values))
+	mad = median(deviations)
+	// scale consistent with the standard deviation of normally distributed traffic
+	scale := 1.4826 * mad
+	if scale == 0 {
+		scale = 1.2533 * meanAD
+	}
+	// request counts vary by at least their square root
+	if noise := math.Sqrt(med); scale < noise {
+		scale = noise
+	}
+	if scale == 0 {
+		return med, mad, 0, false
+	}
+	return med, mad, (float64(current) - med) / scale, true
+}
+
+// buildAnomalySeries assigns samples to the current period ending at end and the same period on each of the previous days.
+// Series without a sample in a window have no requests in the window.
+func buildAnomalySeries(series map[string]*anomalySeries, property string, samples []*trafficSample, end time.Time, length time.Duration, days int) {
+
+	for _, s := range samples {
+		d := end.Sub(s.timestamp)
+		if d <= 0 {
+			continue
+		}
+		k := int((d - 1) / oneDay)
+		if k > days || d > time.Duration(k)*oneDay+length {
+			continue
+		}
+		key := fmt.Sprintf("%s/%d", property, s.datacenterId)
+		entry, ok := series[key]
+		if !ok {
+			entry = &anomalySeries{property: property, datacenterId: s.datacenterId, windows: make([]int64, days+1)}
+			series[key] = entry
+		}
+		if entry.nickname == "" {
+			entry.nickname = s.nickname
+		}
+		entry.windows[k] += s.requests
+	}
+}
+
+// scoreAnomalies scores the current period of each series against its baseline and adds the anomalies to the report, most severe first.
+// Series whose baseline median and current requests are both below minRequests are not evaluated.
+func scoreAnomalies(report *AnomalyReport, series map[string]*anomalySeries, dropsOnly bool) {
+
+	for _, entry := range series {
+		current, baseline := entry.windows[0], entry.windows[1:]
+		med, mad, score, ok := robustScore(current, baseline)
+		if med < float64(report.MinRequests) && current < report.MinRequests {
+			continue
+		}
+		report.Evaluated++
+		if ok && math.Abs(score) < report.Threshold {
+			continue
+		}
+		if !ok && float64(current) == med {
+			continue
+		}
+		anomaly := &TrafficAnomaly{Property: entry.property, DatacenterId: entry.datacenterId, Nickname: entry.nickname, Direction: "spike", Requests: current, Baseline: baseline, BaselineMedian: med, BaselineMAD: mad}
+		if float64(current) < med {
+			anomaly.Direction = "drop"
+		}
+		if dropsOnly && anomaly.Direction != "drop" {
+			continue
+		}
+		if ok {
+			score = math.Round(score*100) / 100
+			anomaly.Score = &score
+		}
+		if med > 0 {
+			change := math.Round((float64(current)-med)/med*10000) / 100
+			anomaly.Change = &change
+		}
+		report.Anomalies = append(report.Anomalies, anomaly)
+	}
+
+	// unscored anomalies have no baseline requests and sort first
+	severity := func(a *TrafficAnomaly) float64 {
+		if a.Score == nil {
+			return math.Inf(1)
+		}
+		return math.Abs(*a.Score)
+	}
+	sort.SliceStable(report.Anomalies, func(i, j int) bool {
+		ai, aj := report.Anomalies[i], report.Anomalies[j]
+		if severity(ai) != severity(aj) {
+			return severity(ai) > severity(aj)
+		}
+		if ai.Property != aj.Property {
+			return ai.Property < aj.Property
+		}
+		return ai.DatacenterId < aj.DatacenterId
+	})
+}
+
+// worker function for anomalies
+func cmdAnomalies(c *cli.Context) error {
+
+	config, err := akamai.GetEdgegridConfig(c)
+	if err != nil {
+		return err
+	}
+	configgtm.Init(config)
+	reportsgtm.Init(config)
+
+	if c.NArg() == 0 {
+		cli.ShowCommandHelp(c, c.Command.Name)
+		return cli.NewExitError(color.RedString("domain is required"), 1)
+	}
+	if c.IsSet("verbose") {
+		verboseStatus = true
+	}
+	domainName := c.Args().First()
+	dcs := (c.Generic("datacenter")).(*arrayFlags)
+	if propertiesSelected(c) && c.IsSet("datacenter") {
+		return cli.NewExitError(color.RedString("property OR datacenter(s) must be specified"), 1)
+	}
+	days := c.Int("baseline-days")
+	if days < minBaselineDays {
+		return cli.NewExitError(color.RedString(fmt.Sprintf("baseline-days must be at least %d", minBaselineDays)), 1)
+	}
+	threshold := c.Float64("threshold")
+	if threshold <= 0 {
+		return cli.NewExitError(color.RedString("threshold must be greater than 0"), 1)
+	}
+	period, err := parseReportPeriod(c, defaultAnomalyPeriod)
+	if err != nil {
+		return cli.NewExitError(color.RedString(err.Error()), 1)
+	}
+
+	trafficType := "property"
+	var properties []string
+	if c.IsSet("datacenter") {
+		trafficType = "datacenter"
+		if err := ResolveDatacenters(c, dcs, domainName, true); err != nil {
+			return cli.NewExitError(color.RedString(err.Error()), 1)
+		}
+	} else {
+		dom, err := cachedDomain(c, domainName)
+		if err != nil {
+			return cli.NewExitError(color.RedString("Domain "+domainName+" not found "), 1)
+		}
+		if propertiesSelected(c) {
+			properties, err = selectProperties(c, dom)
+			if err != nil {
+				return cli.NewExitError(color.RedString(err.Error()), 1)
+			}
+		} else {
+			for _, prop := range dom.Properties {
+				properties = append(properties, prop.Name)
+			}
+		}
+		if len(properties) == 0 {
+			return cli.NewExitError(color.RedString("Domain "+domainName+" has no properties"), 1)
+		}
+	}
+
+	window, err := reportWindow(trafficType)
+	if err != nil {
+		return cli.NewExitError(color.RedString(statusErrorText(err)), 1)
+	}
+	start, end, err := period.resolve(window)
+	if err != nil {
+		return cli.NewExitError(color.RedString(err.Error()), 1)
+	}
+	length := end.Sub(start)
+	if length > oneDay {
+		return cli.NewExitError(color.RedString("period must not exceed 1d"), 1)
+	}
+	// use the days of available report data if fewer than requested
+	if available := int(start.Sub(window.StartTime) / oneDay); available < days {
+		if available < minBaselineDays {
+			return cli.NewExitError(color.RedString(fmt.Sprintf("Report data since %s is insufficient for a %d day baseline", period.format(window.StartTime), minBaselineDays)), 1)
+		}
+		days = available
+	}
+
+	report := &AnomalyReport{Domain: domainName, PeriodStart: period.format(start), PeriodEnd: period.format(end), BaselineDays: days, Threshold: threshold, MinRequests: c.Int64("min-requests")}
+	baselineStart := start.Add(-time.Duration(days) * oneDay)
+	interactive := !c.Bool("json")
+	if interactive {
+		akamai.StartSpinner("Collecting traffic ", "")
+	}
+	series := make(map[string]*anomalySeries)
+	if trafficType == "datacenter" {
+		samples, err := fetchDatacenterTraffic(domainName, dcs.flagList, baselineStart, end)
+		if err != nil {
+			if interactive {
+				akamai.StopSpinnerFail()
+			}
+			return cli.NewExitError(color.RedString(statusErrorText(err)), 1)
+		}
+		buildAnomalySeries(series, "", samples, end, length, days)
+	} else {
+		var mu sync.Mutex
+		var wg sync.WaitGroup
+		sem := make(chan struct{}, reportConcurrency)
+		for _, propName := range properties {
+			wg.Add(1)
+			go func(propName string) {
+				defer wg.Done()
+				sem <- struct{}{}
+				defer func() { <-sem }()
+				samples, err := fetchPropertyTraffic(domainName, propName, baselineStart, end)
+				mu.Lock()
+				defer mu.Unlock()
+				if err != nil {
+					report.Errors = append(report.Errors, fmt.Sprintf("%s: %s", propName, err.Error()))
+					return
+				}
+				buildAnomalySeries(series, propName, samples, end, length, days)
+			}(propName)
+		}
+		wg.Wait()
+		sort.Strings(report.Errors)
+		if len(report.Errors) == len(properties) {
+			if interactive {
+				akamai.StopSpinnerFail()
+			}
+			return cli.NewExitError(color.RedString(statusErrorText(fmt.Errorf("%s", report.Errors[0]))), 1)
+		}
+	}
+	scoreAnomalies(report, series, c.Bool("drops-only"))
+	if interactive {
+		akamai.StopSpinnerOk()
+	}
+
+	if c.Bool("json") {
+		json, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return cli.NewExitError(color.RedString("Unable to display anomalies"), 1)
+		}
+		fmt.Fprintln(c.App.Writer, string(json))
+	} else {
+		fmt.Fprintln(c.App.Writer, renderAnomalies(report))
+	}
+
+	return nil
+}
+
+// Pretty print traffic anomalies
+func renderAnomalies(report *AnomalyReport) string {
+
+	var outString string
+	outString += fmt.Sprintln(" ")
+	outString += fmt.Sprintln("Domain: ", report.Domain)
+	outString += fmt.Sprintln("Period Start: ", report.PeriodStart)
+	outString += fmt.Sprintln("Period End: ", report.PeriodEnd)
+	outString += fmt.Sprintln(fmt.Sprintf("Baseline: same period on the previous %d days. Threshold: %s. Evaluated: %d, Anomalies: %d", report.BaselineDays, strconv.FormatFloat(report.Threshold, 'f', -1, 64), report.Evaluated, len(report.Anomalies)))
+	outString += fmt.Sprintln(" ")
+
+	tableString := &strings.Builder{}
+	table := newTrafficTable(tableString, []string{"Property", "Datacenter", "Nickname", "Direction", "Requests", "Baseline Median", "Change", "Score"})
+	table.SetColumnAlignment([]int{tablewriter.ALIGN_LEFT, tablewriter.ALIGN_CENTER, tablewriter.ALIGN_LEFT, tablewriter.ALIGN_LEFT, tablewriter.ALIGN_RIGHT, tablewriter.ALIGN_RIGHT, tablewriter.ALIGN_RIGHT, tablewriter.ALIGN_RIGHT})
+	if len(report.Anomalies) == 0 {
+		table.Append([]string{"No anomalies", "", "", "", "", "", "", ""})
+	}
+	for _, anomaly := range report.Anomalies {
+		direction := color.YellowString(anomaly.Direction)
+		if anomaly.Direction == "drop" {
+			direction = color.RedString(anomaly.Direction)
+		}
+		change, score := "n/a", "n/a"
+		if anomaly.Change != nil {
+			change = fmt.Sprintf("%+.2f%%", *anomaly.Change)
+		}
+		if anomaly.Score != nil {
+			score = fmt.Sprintf("%+.2f", *anomaly.Score)
+		}
+		property := anomaly.Property
+		if property == "" {
+			property = "all"
+		}
+		table.Append([]string{property, strconv.Itoa(anomaly.DatacenterId), anomaly.Nickname, direction, strconv.FormatInt(anomaly.Requests, 10), strconv.FormatFloat(anomaly.BaselineMedian, 'f', -1, 64), change, score})
+	}
+	table.Render()
+	outString += fmt.Sprintln(tableString.String())
+
+	// failed properties are always counted. Listed with verbose
+	if len(report.Errors) > 0 {
+		if !verboseStatus {
+			outString += fmt.Sprintln(fmt.Sprintf("Unable to retrieve traffic of %d properties. Use --verbose to list them", len(report.Errors)))
+		} else {
+			outString += fmt.Sprintln(fmt.Sprintf("Unable to retrieve traffic of %d properties:", len(report.Errors)))
+			for _, e := range report.Errors {
+				outString += fmt.Sprintln("   " + e)
+			}
+		}
+	}
+	return outString
+}
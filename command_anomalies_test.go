@@ -0,0 +1,193 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMedian(t *testing.T) {
+
+	tests := []struct {
+		values []float64
+		want   float64
+	}{
+		{nil, 0},
+		{[]float64{5}, 5},
+		{[]float64{3, 1, 2}, 2},
+		{[]float64{4, 1, 3, 2}, 2.5},
+	}
+	for _, tt := range tests {
+		if got := median(tt.values); got != tt.want {
+			t.Errorf("median(%v) = %v, want %v", tt.values, got, tt.want)
+		}
+	}
+}
+
+func TestRobustScore(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		current   int64
+		baseline  []int64
+		wantMed   float64
+		wantMAD   float64
+		wantScore float64
+		wantOK    bool
+	}{
+		{"flat baseline unchanged", 100, []int64{100, 100, 100, 100, 100, 100, 100}, 100, 0, 0, true},
+		{"flat baseline spike uses square root floor", 150, []int64{100, 100, 100, 100, 100, 100, 100}, 100, 0, 5, true},
+		{"small mad uses square root floor", 140, []int64{90, 100, 110, 100, 95, 105, 100}, 100, 5, 4, true},
+		{"mad scale", 704, []int64{1000, 1200, 800, 1000, 1100, 900, 1000}, 1000, 100, -296 / (1.4826 * 100), true},
+		{"mean absolute deviation fallback", 500, []int64{0, 0, 0, 0, 1000}, 0, 0, 500 / (1.2533 * 200), true},
+		{"zero baseline", 500, []int64{0, 0, 0}, 0, 0, 0, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			med, mad, score, ok := robustScore(tt.current, tt.baseline)
+			if med != tt.wantMed || mad != tt.wantMAD || ok != tt.wantOK || math.Abs(score-tt.wantScore) > 1e-9 {
+				t.Errorf("robustScore() = %v, %v, %v, %v, want %v, %v, %v, %v", med, mad, score, ok, tt.wantMed, tt.wantMAD, tt.wantScore, tt.wantOK)
+			}
+		})
+	}
+}
+
+func TestBuildAnomalySeries(t *testing.T) {
+
+	end := mustParseTime(t, "2026-03-10T12:00:00Z")
+	sample := func(offset time.Duration, dcId int, requests int64) *trafficSample {
+		return &trafficSample{timestamp: end.Add(offset), datacenterId: dcId, nickname: fmt.Sprintf("dc%d", dcId), requests: requests}
+	}
+	samples := []*trafficSample{
+		sample(-time.Hour, 3131, 1),                // current period start, included
+		sample(-5*time.Minute, 3131, 2),            // current period
+		sample(0, 3131, 1000),                      // period end, excluded
+		sample(-time.Hour-time.Minute, 3131, 1000), // before current period
+		sample(-oneDay, 3131, 1000),                // one day earlier at period end, excluded
+		sample(-oneDay-time.Hour, 3131, 4),         // one day earlier at period start
+		sample(-oneDay-30*time.Minute, 3131, 8),
+		sample(-2*oneDay-30*time.Minute, 3132, 16),
+		sample(-3*oneDay-30*time.Minute, 3131, 32), // beyond baseline days
+		sample(time.Minute, 3131, 1000),            // after end
+	}
+	series := make(map[string]*anomalySeries)
+	buildAnomalySeries(series, "www", samples, end, time.Hour, 2)
+
+	want := map[string][]int64{
+		"www/3131": {3, 12, 0},
+		"www/3132": {0, 0, 16},
+	}
+	if len(series) != len(want) {
+		t.Fatalf("%d series, want %d", len(series), len(want))
+	}
+	for key, windows := range want {
+		entry, ok := series[key]
+		if !ok {
+			t.Fatalf("series %s missing", key)
+		}
+		if !reflect.DeepEqual(entry.windows, windows) {
+			t.Errorf("series %s windows %v, want %v", key, entry.windows, windows)
+		}
+		if entry.property != "www" || entry.nickname != fmt.Sprintf("dc%d", entry.datacenterId) {
+			t.Errorf("series %s = %+v", key, entry)
+		}
+	}
+}
+
+func TestScoreAnomalies(t *testing.T) {
+
+	newSeries := func() map[string]*anomalySeries {
+		flat := func(current int64, baseline int64) []int64 {
+			return []int64{current, baseline, baseline, baseline, baseline, baseline, baseline, baseline}
+		}
+		return map[string]*anomalySeries{
+			"www/1": {property: "www", datacenterId: 1, nickname: "steady", windows: flat(100, 100)},
+			"www/2": {property: "www", datacenterId: 2, nickname: "drop", windows: flat(10, 1000)},
+			"www/3": {property: "www", datacenterId: 3, nickname: "new", windows: flat(500, 0)},
+			"www/4": {property: "www", datacenterId: 4, nickname: "quiet", windows: flat(5, 50)},
+			"api/2": {property: "api", datacenterId: 2, nickname: "spike", windows: flat(1300, 1000)},
+		}
+	}
+	tests := []struct {
+		name      string
+		dropsOnly bool
+		want      []string
+	}{
+		{"all", false, []string{"www/3 spike score none change none", "www/2 drop score -31.31 change -99", "api/2 spike score 9.49 change 30"}},
+		{"drops only", true, []string{"www/2 drop score -31.31 change -99"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			report := &AnomalyReport{Threshold: defaultAnomalyThreshold, MinRequests: defaultAnomalyMinimum}
+			scoreAnomalies(report, newSeries(), tt.dropsOnly)
+			if report.Evaluated != 4 {
+				t.Errorf("evaluated %d, want 4", report.Evaluated)
+			}
+			var got []string
+			for _, a := range report.Anomalies {
+				score, change := "none", "none"
+				if a.Score != nil {
+					score = fmt.Sprint(*a.Score)
+				}
+				if a.Change != nil {
+					change = fmt.Sprint(*a.Change)
+				}
+				got = append(got, fmt.Sprintf("%s/%d %s score %s change %s", a.Property, a.DatacenterId, a.Direction, score, change))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("anomalies %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRenderAnomaliesErrors(t *testing.T) {
+
+	defer func(verbose bool) { verboseStatus = verbose }(verboseStatus)
+	report := &AnomalyReport{Domain: "example.akadns.net", Errors: []string{"api: timeout", "www: not found"}}
+	tests := []struct {
+		verbose bool
+		want    []string
+		notWant []string
+	}{
+		{false, []string{"Unable to retrieve traffic of 2 properties. Use --verbose to list them"}, []string{"api: timeout"}},
+		{true, []string{"Unable to retrieve traffic of 2 properties:", "   api: timeout", "   www: not found"}, []string{"Use --verbose"}},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprintf("verbose %v", tt.verbose), func(t *testing.T) {
+			verboseStatus = tt.verbose
+			out := renderAnomalies(report)
+			for _, want := range tt.want {
+				if !strings.Contains(out, want) {
+					t.Errorf("output does not contain %q:\n%s", want, out)
+				}
+			}
+			for _, notWant := range tt.notWant {
+				if strings.Contains(out, notWant) {
+					t.Errorf("output contains %q:\n%s", notWant, out)
+				}
+			}
+		})
+	}
+	report.Errors = nil
+	if out := renderAnomalies(report); strings.Contains(out, "Unable to retrieve traffic") {
+		t.Errorf("unexpected failed properties:\n%s", out)
+	}
+}
@@ -0,0 +1,334 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/reportsgtm-v1"
+	akamai "github.com/akamai/cli-common-golang"
+	"github.com/urfave/cli"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Nagios plugin states, also the exit codes
+const (
+	checkOK       = 0
+	checkWarning  = 1
+	checkCritical = 2
+	checkUnknown  = 3
+)
+
+var checkStateNames = []string{"OK", "WARNING", "CRITICAL", "UNKNOWN"}
+
+// a warning or critical problem takes precedence over unknown
+var checkSeverity = map[int]int{checkOK: 0, checkUnknown: 1, checkWarning: 2, checkCritical: 3}
+
+// checkThreshold is a warning and critical threshold pair. Unset thresholds are nil.
+type checkThreshold struct {
+	warning  *float64
+	critical *float64
+	minimum  bool // value must be at least the threshold, otherwise at most
+}
+
+// thresholdFlags returns the threshold set by the <name>-warning and <name>-critical flags
+func thresholdFlags(c *cli.Context, name string, minimum bool) (checkThreshold, error) {
+
+	t := checkThreshold{minimum: minimum}
+	for _, level := range []string{"warning", "critical"} {
+		flag := name + "-" + level
+		if !c.IsSet(flag) {
+			continue
+		}
+		v, err := strconv.ParseFloat(c.String(flag), 64)
+		if err != nil || v < 0 {
+			return t, fmt.Errorf("Invalid %s %s. Must be a non-negative number", flag, c.String(flag))
+		}
+		if level == "warning" {
+			t.warning = &v
+		} else {
+			t.critical = &v
+		}
+	}
+	return t, nil
+}
+
+// isSet returns whether either threshold is set
+func (t checkThreshold) isSet() bool {
+
+	return t.warning != nil || t.critical != nil
+}
+
+// breached returns whether value breaches the threshold
+func (t checkThreshold) breached(threshold *float64, value float64) bool {
+
+	if threshold == nil {
+		return false
+	}
+	if t.minimum {
+		return value < *threshold
+	}
+	return value > *threshold
+}
+
+// state returns the state of value and the breached threshold
+func (t checkThreshold) state(value float64) (int, float64) {
+
+	if t.breached(t.critical, value) {
+		return checkCritical, *t.critical
+	}
+	if t.breached(t.warning, value) {
+		return checkWarning, *t.warning
+	}
+	return checkOK, 0
+}
+
+// perfRange formats a threshold as a Nagios range. Minimum thresholds alert below the value, e.g. 2:
+func (t checkThreshold) perfRange(threshold *float64) string {
+
+	if threshold == nil {
+		return ""
+	}
+	if t.minimum {
+		return formatMetricValue(*threshold) + ":"
+	}
+	return formatMetricValue(*threshold)
+}
+
+// checkResult accumulates the state, problems and perfdata of a check
+type checkResult struct {
+	state    int
+	checked  []string
+	problems map[int][]string
+	perfdata []string
+}
+
+// newCheckResult returns an OK result
+func newCheckResult() *checkResult {
+
+	return &checkResult{state: checkOK, problems: make(map[int][]string)}
+}
+
+// raise records a problem. The result state is the worst state raised.
+func (r *checkResult) raise(state int, message string) {
+
+	r.problems[state] = append(r.problems[state], message)
+	if checkSeverity[state] > checkSeverity[r.state] {
+		r.state = state
+	}
+}
+
+// measure evaluates value against the threshold and records its perfdata
+func (r *checkResult) measure(t checkThreshold, label string, value float64, unit string, max string, describe string) {
+
+	if state, threshold := t.state(value); state != checkOK {
+		relation := "above"
+		if t.minimum {
+			relation = "below"
+		}
+		r.raise(state, fmt.Sprintf("%s %s %s %s", describe, formatMetricValue(value)+unit, relation, formatMetricValue(threshold)+unit))
+	}
+	label = strings.ReplaceAll(label, "'", "")
+	r.perfdata = append(r.perfdata, fmt.Sprintf("'%s'=%s%s;%s;%s;0;%s", label, formatMetricValue(value), unit, t.perfRange(t.warning), t.perfRange(t.critical), max))
+}
+
+// render returns the status line with perfdata followed by one line per problem, worst first
+func (r *checkResult) render(domain string) string {
+
+	var lines []string
+	for _, state := range []int{checkCritical, checkWarning, checkUnknown} {
+		for _, problem := range r.problems[state] {
+			lines = append(lines, checkStateNames[state]+": "+problem)
+		}
+	}
+	summary := fmt.Sprintf("%s: %s checked", domain, strings.Join(r.checked, ", "))
+	if len(lines) > 0 {
+		summary = fmt.Sprintf("%s: %s", domain, strings.SplitN(lines[0], ": ", 2)[1])
+		if len(lines) > 1 {
+			summary += fmt.Sprintf(" (%d more)", len(lines)-1)
+		}
+	}
+	out := fmt.Sprintf("GTM %s - %s", checkStateNames[r.state], summary)
+	if len(r.perfdata) > 0 {
+		out += " | " + strings.Join(r.perfdata, " ")
+	}
+	out += "\n"
+	for _, line := range lines {
+		out += line + "\n"
+	}
+	return out
+}
+
+// checkPropagation checks how long the domain propagation has been pending
+func checkPropagation(r *checkResult, t checkThreshold, status *configgtm.ResponseStatus, now time.Time) {
+
+	minutes := 0.0
+	if status.PropagationStatus == "PENDING" {
+		changed, err := time.Parse(time.RFC3339, status.PropagationStatusDate)
+		if err != nil {
+			r.raise(checkUnknown, "propagation PENDING since unknown time "+status.PropagationStatusDate)
+			return
+		}
+		minutes = float64(int(now.Sub(changed).Minutes()))
+	}
+	r.measure(t, "propagation_pending_minutes", minutes, "", "", "propagation PENDING minutes")
+}
+
+// checkEnabledTargets checks the number of enabled traffic targets of each property
+func checkEnabledTargets(r *checkResult, t checkThreshold, dom *configgtm.Domain, properties []string) {
+
+	for _, propName := range properties {
+		for _, prop := range dom.Properties {
+			if prop.Name != propName {
+				continue
+			}
+			enabled := 0
+			for _, tgt := range prop.TrafficTargets {
+				if tgt.Enabled {
+					enabled++
+				}
+			}
+			r.measure(t, propName+"_enabled_targets", float64(enabled), "", strconv.Itoa(len(prop.TrafficTargets)), propName+" enabled targets")
+		}
+	}
+}
+
+// checkPropertyStatus checks the alive IPs of each enabled datacenter and the largest datacenter share of requests of the property
+func checkPropertyStatus(r *checkResult, alive checkThreshold, share checkThreshold, propStat *PropertyStatus) {
+
+	if propStat.Error != "" {
+		r.raise(checkUnknown, fmt.Sprintf("%s status unavailable. %s", propStat.PropertyName, propStat.Error))
+		return
+	}
+	dcStats := propStat.StatusSummary.PropertyDCStatus
+	sort.Slice(dcStats, func(i, j int) bool { return dcStats[i].DatacenterId < dcStats[j].DatacenterId })
+	var total, max int64
+	maxDC := ""
+	for _, dc := range dcStats {
+		label := dc.Nickname
+		if label == "" {
+			label = strconv.Itoa(dc.DatacenterId)
+		}
+		total += dc.DCTotalPeriodRequests
+		if dc.DCTotalPeriodRequests > max {
+			max = dc.DCTotalPeriodRequests
+			maxDC = label
+		}
+		// datacenters without reported IPs have no liveness status
+		if !alive.isSet() || !dc.DCEnabled || len(dc.IPs) == 0 {
+			continue
+		}
+		aliveIPs := 0
+		for _, ip := range dc.IPs {
+			if ip.Alive {
+				aliveIPs++
+			}
+		}
+		r.measure(alive, propStat.PropertyName+"_"+label+"_alive", float64(aliveIPs), "", strconv.Itoa(len(dc.IPs)), fmt.Sprintf("%s datacenter %s alive IPs", propStat.PropertyName, label))
+	}
+	if share.isSet() && total > 0 {
+		pct := float64(int(float64(max)/float64(total)*10000)) / 100
+		r.measure(share, propStat.PropertyName+"_max_share", pct, "%", "100", fmt.Sprintf("%s datacenter %s share of requests", propStat.PropertyName, maxDC))
+	}
+}
+
+// worker function for check
+func cmdCheck(c *cli.Context) error {
+
+	result := newCheckResult()
+	unknown := func(message string) error {
+		fmt.Fprintln(c.App.Writer, "GTM UNKNOWN - "+message)
+		return cli.NewExitError("", checkUnknown)
+	}
+
+	config, err := akamai.GetEdgegridConfig(c)
+	if err != nil {
+		return unknown(err.Error())
+	}
+	configgtm.Init(config)
+	reportsgtm.Init(config)
+
+	if c.NArg() == 0 {
+		return unknown("domain is required")
+	}
+	domainName = c.Args().First()
+	thresholds := make(map[string]checkThreshold)
+	for _, name := range []string{"alive", "share", "enabled", "pending"} {
+		// alive IPs and enabled targets are minimums
+		thresholds[name], err = thresholdFlags(c, name, name == "alive" || name == "enabled")
+		if err != nil {
+			return unknown(err.Error())
+		}
+	}
+	alive, share, enabled, pending := thresholds["alive"], thresholds["share"], thresholds["enabled"], thresholds["pending"]
+	if !alive.isSet() && !share.isSet() && !enabled.isSet() && !pending.isSet() {
+		return unknown("No thresholds specified. Specify at least one alive, share, enabled or pending threshold")
+	}
+	qsPeriod, err = parseReportPeriod(c, defaultPeriod)
+	if err != nil {
+		return unknown(err.Error())
+	}
+
+	// domain configuration is retrieved from GTM so enabled targets are current
+	qsDomain, err = configgtm.GetDomain(domainName)
+	if err != nil {
+		return unknown("Domain " + domainName + " not found")
+	}
+	if c.IsSet("property") {
+		qsProperties, err = selectProperties(c, qsDomain)
+		if err != nil {
+			return unknown(err.Error())
+		}
+	} else {
+		qsProperties = nil
+		for _, prop := range qsDomain.Properties {
+			qsProperties = append(qsProperties, prop.Name)
+		}
+		sort.Strings(qsProperties)
+	}
+
+	if pending.isSet() {
+		status, err := configgtm.GetDomainStatus(domainName)
+		if err != nil {
+			return unknown("Unable to retrieve domain status. " + err.Error())
+		}
+		checkPropagation(result, pending, status, time.Now())
+		result.checked = append(result.checked, "propagation")
+	}
+	if enabled.isSet() {
+		checkEnabledTargets(result, enabled, qsDomain, qsProperties)
+	}
+	if (alive.isSet() || share.isSet()) && len(qsProperties) > 0 {
+		propStats, err := gatherPropertiesStatus()
+		if err != nil {
+			return unknown("Unable to retrieve property status. " + statusErrorText(err))
+		}
+		for _, propStat := range propStats {
+			checkPropertyStatus(result, alive, share, propStat)
+		}
+	}
+	if enabled.isSet() || alive.isSet() || share.isSet() {
+		result.checked = append(result.checked, fmt.Sprintf("%d properties", len(qsProperties)))
+	}
+
+	fmt.Fprint(c.App.Writer, result.render(domainName))
+	if result.state != checkOK {
+		return cli.NewExitError("", result.state)
+	}
+	return nil
+}
@@ -0,0 +1,123 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+)
+
+// floatPtr returns a pointer to v
+func floatPtr(v float64) *float64 {
+
+	return &v
+}
+
+func TestCheckThresholdState(t *testing.T) {
+
+	maximum := checkThreshold{warning: floatPtr(10), critical: floatPtr(30)}
+	minimum := checkThreshold{warning: floatPtr(3), critical: floatPtr(1), minimum: true}
+	tests := []struct {
+		name          string
+		threshold     checkThreshold
+		value         float64
+		wantState     int
+		wantThreshold float64
+	}{
+		{"maximum ok", maximum, 5, checkOK, 0},
+		{"maximum at warning", maximum, 10, checkOK, 0},
+		{"maximum warning", maximum, 10.5, checkWarning, 10},
+		{"maximum critical", maximum, 31, checkCritical, 30},
+		{"minimum ok", minimum, 4, checkOK, 0},
+		{"minimum at warning", minimum, 3, checkOK, 0},
+		{"minimum warning", minimum, 2, checkWarning, 3},
+		{"minimum critical", minimum, 0, checkCritical, 1},
+		{"critical only", checkThreshold{critical: floatPtr(50)}, 40, checkOK, 0},
+		{"unset", checkThreshold{}, 1000, checkOK, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state, threshold := tt.threshold.state(tt.value)
+			if state != tt.wantState || threshold != tt.wantThreshold {
+				t.Errorf("state(%v) = %d, %v, want %d, %v", tt.value, state, threshold, tt.wantState, tt.wantThreshold)
+			}
+		})
+	}
+}
+
+func TestCheckThresholdPerfRange(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		threshold checkThreshold
+		value     *float64
+		want      string
+	}{
+		{"unset", checkThreshold{}, nil, ""},
+		{"maximum", checkThreshold{}, floatPtr(10), "10"},
+		{"maximum fraction", checkThreshold{}, floatPtr(2.5), "2.5"},
+		{"minimum", checkThreshold{minimum: true}, floatPtr(2), "2:"},
+		{"minimum unset", checkThreshold{minimum: true}, nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.threshold.perfRange(tt.value); got != tt.want {
+				t.Errorf("perfRange() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCheckResultRender(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		build func(r *checkResult)
+		want  string
+	}{
+		{"ok", func(r *checkResult) {
+			r.checked = []string{"propagation", "targets"}
+			r.measure(checkThreshold{warning: floatPtr(10), critical: floatPtr(30)}, "propagation_minutes", 2, "", "", "propagation pending for")
+		}, "GTM OK - example.akadns.net: propagation, targets checked | 'propagation_minutes'=2;10;30;0;\n"},
+		{"warning", func(r *checkResult) {
+			r.checked = []string{"targets"}
+			r.measure(checkThreshold{warning: floatPtr(3), critical: floatPtr(1), minimum: true}, "www enabled_targets", 2, "", "4", "property www enabled targets")
+		}, "GTM WARNING - example.akadns.net: property www enabled targets 2 below 3 | 'www enabled_targets'=2;3:;1:;0;4\n" +
+			"WARNING: property www enabled targets 2 below 3\n"},
+		{"worst problem first", func(r *checkResult) {
+			r.raise(checkUnknown, "property api status unavailable")
+			r.raise(checkWarning, "property www alive 50% below 75%")
+			r.raise(checkCritical, "property img alive 0% below 25%")
+		}, "GTM CRITICAL - example.akadns.net: property img alive 0% below 25% (2 more)\n" +
+			"CRITICAL: property img alive 0% below 25%\nWARNING: property www alive 50% below 75%\nUNKNOWN: property api status unavailable\n"},
+		{"unknown ranks below warning", func(r *checkResult) {
+			r.raise(checkWarning, "propagation pending for 12 above 10")
+			r.raise(checkUnknown, "property api status unavailable")
+		}, "GTM WARNING - example.akadns.net: propagation pending for 12 above 10 (1 more)\n" +
+			"WARNING: propagation pending for 12 above 10\nUNKNOWN: property api status unavailable\n"},
+		{"quotes removed from label", func(r *checkResult) {
+			r.checked = []string{"status"}
+			r.measure(checkThreshold{}, "it's", 1, "%", "100", "share")
+		}, "GTM OK - example.akadns.net: status checked | 'its'=1%;;;0;100\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newCheckResult()
+			tt.build(r)
+			if got := r.render("example.akadns.net"); got != tt.want {
+				t.Errorf("render() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
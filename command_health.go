@@ -0,0 +1,311 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/reportsgtm-v1"
+	akamai "github.com/akamai/cli-common-golang"
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+const (
+	healthOK       = "OK"
+	healthDegraded = "degraded"
+	healthDown     = "down"
+	healthUnknown  = "unknown"
+)
+
+var healthRank = map[string]int{healthDown: 0, healthDegraded: 1, healthUnknown: 2, healthOK: 3}
+
+// DomainHealth is the health result structure
+type DomainHealth struct {
+	Domain     string
+	Properties []*PropertyHealth
+}
+
+// PropertyHealth represents the most recent IP status of a property
+type PropertyHealth struct {
+	Property    string
+	Status      string
+	LastUpdate  string
+	Datacenters []*DatacenterHealth
+	Error       string `json:",omitempty"`
+}
+
+// DatacenterHealth summarizes the IP status of a property traffic target
+type DatacenterHealth struct {
+	DatacenterId int
+	Nickname     string
+	IPs          int
+	Alive        int
+	HandedOut    int
+	Score        float64
+}
+
+// summarizeDatacenterHealth summarizes the IP status of a traffic target
+func summarizeDatacenterHealth(row *reportsgtm.IpStatPerPropDRow) *DatacenterHealth {
+
+	dcHealth := &DatacenterHealth{DatacenterId: row.DatacenterId, Nickname: row.Nickname, IPs: len(row.IPs)}
+	for _, ip := range row.IPs {
+		if ip.Alive {
+			dcHealth.Alive++
+		}
+		if ip.HandedOut {
+			dcHealth.HandedOut++
+		}
+		if float64(ip.Score) > dcHealth.Score {
+			dcHealth.Score = float64(ip.Score)
+		}
+	}
+	return dcHealth
+}
+
+// rollupPropertyHealth returns OK if all IPs are alive, down if no alive IP is handed out, otherwise degraded
+func rollupPropertyHealth(propHealth *PropertyHealth) string {
+
+	if len(propHealth.Datacenters) == 0 {
+		return healthUnknown
+	}
+	ips, alive, aliveHandedOut := 0, 0, 0
+	for _, dc := range propHealth.Datacenters {
+		ips += dc.IPs
+		alive += dc.Alive
+		if dc.Alive > 0 && dc.HandedOut > 0 {
+			aliveHandedOut++
+		}
+	}
+	if aliveHandedOut == 0 {
+		return healthDown
+	}
+	if alive < ips {
+		return healthDegraded
+	}
+	return healthOK
+}
+
+// gatherPropertyHealth retrieves the most recent IP status of the property
+func gatherPropertyHealth(domainName string, propName string) *PropertyHealth {
+
+	propHealth := &PropertyHealth{Property: propName}
+	optArgs := map[string]string{"mostRecent": "true"}
+	ipStatus, err := reportsgtm.GetIpStatusPerProperty(domainName, propName, optArgs)
+	if err != nil {
+		propHealth.Status = healthUnknown
+		propHealth.Error = err.Error()
+		return propHealth
+	}
+	if len(ipStatus.DataRows) > 0 {
+		propHealth.LastUpdate = ipStatus.DataRows[0].Timestamp
+		for _, row := range ipStatus.DataRows[0].Datacenters {
+			propHealth.Datacenters = append(propHealth.Datacenters, summarizeDatacenterHealth(row))
+		}
+	}
+	propHealth.Status = rollupPropertyHealth(propHealth)
+	return propHealth
+}
+
+// gatherDomainHealth retrieves the health of all domain properties, sorted worst first
+func gatherDomainHealth(dom *configgtm.Domain) *DomainHealth {
+
+	health := &DomainHealth{Domain: dom.Name, Properties: make([]*PropertyHealth, len(dom.Properties))}
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, reportConcurrency)
+	for i, prop := range dom.Properties {
+		wg.Add(1)
+		go func(i int, propName string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			health.Properties[i] = gatherPropertyHealth(dom.Name, propName)
+		}(i, prop.Name)
+	}
+	wg.Wait()
+
+	sort.SliceStable(health.Properties, func(i, j int) bool {
+		pi, pj := health.Properties[i], health.Properties[j]
+		if healthRank[pi.Status] != healthRank[pj.Status] {
+			return healthRank[pi.Status] < healthRank[pj.Status]
+		}
+		return pi.Property < pj.Property
+	})
+	return health
+}
+
+// worker function for health
+func cmdHealth(c *cli.Context) error {
+
+	config, err := akamai.GetEdgegridConfig(c)
+	if err != nil {
+		return err
+	}
+	configgtm.Init(config)
+	reportsgtm.Init(config)
+
+	if c.NArg() == 0 {
+		cli.ShowCommandHelp(c, c.Command.Name)
+		return cli.NewExitError(color.RedString("domain is required"), 1)
+	}
+	if c.IsSet("verbose") {
+		verboseStatus = true
+	}
+	domainName := c.Args().First()
+
+	if !c.IsSet("json") {
+		akamai.StartSpinner("Collecting property health ", "")
+	}
+	dom, err := cachedDomain(c, domainName)
+	if err != nil {
+		if !c.IsSet("json") {
+			akamai.StopSpinnerFail()
+		}
+		return cli.NewExitError(color.RedString("Domain "+domainName+" not found "), 1)
+	}
+	health := gatherDomainHealth(dom)
+	if !c.IsSet("json") {
+		akamai.StopSpinnerOk()
+	}
+
+	if c.IsSet("json") && c.Bool("json") {
+		json, err := json.MarshalIndent(health, "", "  ")
+		if err != nil {
+			return cli.NewExitError(color.RedString("Unable to display health results"), 1)
+		}
+		fmt.Fprintln(c.App.Writer, string(json))
+	} else {
+		fmt.Fprintln(c.App.Writer, renderHealthTable(health))
+	}
+
+	return nil
+}
+
+// healthDatacenterColumns returns the datacenters reported for any property, ordered by id, and their column labels
+func healthDatacenterColumns(health *DomainHealth) ([]int, map[int]string) {
+
+	labels := make(map[int]string)
+	for _, prop := range health.Properties {
+		for _, dc := range prop.Datacenters {
+			if _, ok := labels[dc.DatacenterId]; ok {
+				continue
+			}
+			labels[dc.DatacenterId] = strconv.Itoa(dc.DatacenterId)
+			if dc.Nickname != "" {
+				labels[dc.DatacenterId] = dc.Nickname
+			}
+		}
+	}
+	var ids []int
+	for id := range labels {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids, labels
+}
+
+// colorHealth colors a health status
+func colorHealth(status string) string {
+
+	switch status {
+	case healthDown:
+		return color.RedString(status)
+	case healthDegraded, healthUnknown:
+		return color.YellowString(status)
+	}
+	return color.GreenString(status)
+}
+
+// Pretty print property x datacenter health matrix
+func renderHealthTable(health *DomainHealth) string {
+
+	counts := make(map[string]int)
+	for _, prop := range health.Properties {
+		counts[prop.Status]++
+	}
+	var outString string
+	outString += fmt.Sprintln(" ")
+	outString += fmt.Sprintln(fmt.Sprintf("Domain: %s", health.Domain))
+	outString += fmt.Sprintln(fmt.Sprintf("Properties: %d, Down: %d, Degraded: %d, Unknown: %d, OK: %d", len(health.Properties), counts[healthDown], counts[healthDegraded], counts[healthUnknown], counts[healthOK]))
+	outString += fmt.Sprintln("Datacenter cells: alive/IPs, handed out (H), highest score (S)")
+	outString += fmt.Sprintln(" ")
+	tableString := &strings.Builder{}
+	table := tablewriter.NewWriter(tableString)
+
+	dcIds, labels := healthDatacenterColumns(health)
+	header := []string{"Property", "Health"}
+	alignment := []int{tablewriter.ALIGN_LEFT, tablewriter.ALIGN_LEFT}
+	for _, id := range dcIds {
+		header = append(header, labels[id])
+		alignment = append(alignment, tablewriter.ALIGN_CENTER)
+	}
+	table.SetHeader(header)
+	table.SetAutoFormatHeaders(false)
+	table.SetReflowDuringAutoWrap(false)
+	table.SetCenterSeparator(" ")
+	table.SetColumnSeparator(" ")
+	table.SetRowSeparator(" ")
+	table.SetBorder(false)
+	table.SetAutoWrapText(false)
+	table.SetColumnAlignment(alignment)
+	table.SetAlignment(tablewriter.ALIGN_CENTER)
+
+	if len(health.Properties) == 0 {
+		rowData := make([]string, len(header))
+		rowData[0] = "No properties"
+		table.Append(rowData)
+	}
+	var failures []string
+	for _, prop := range health.Properties {
+		rowData := []string{prop.Property, colorHealth(prop.Status)}
+		cells := make(map[int]string)
+		for _, dc := range prop.Datacenters {
+			cell := fmt.Sprintf("%d/%d H%d S%.2f", dc.Alive, dc.IPs, dc.HandedOut, dc.Score)
+			if dc.Alive == 0 {
+				cell = color.RedString(cell)
+			} else if dc.Alive < dc.IPs {
+				cell = color.YellowString(cell)
+			}
+			cells[dc.DatacenterId] = cell
+		}
+		for _, id := range dcIds {
+			cell, ok := cells[id]
+			if !ok {
+				cell = "-"
+			}
+			rowData = append(rowData, cell)
+		}
+		table.Append(rowData)
+		if prop.Error != "" {
+			failures = append(failures, fmt.Sprintf("%s: %s", prop.Property, prop.Error))
+		}
+	}
+	table.Render()
+	outString += fmt.Sprintln(tableString.String())
+
+	if verboseStatus && len(failures) > 0 {
+		outString += fmt.Sprintln("Unable to retrieve status of properties:")
+		for _, e := range failures {
+			outString += fmt.Sprintln("   " + e)
+		}
+	}
+	return outString
+}
@@ -0,0 +1,82 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/reportsgtm-v1"
+	"reflect"
+	"testing"
+)
+
+// testIPStatusRow returns a datacenter IP status row with one IP per alive and handed out pair
+func testIPStatusRow(dcId int, ips ...[2]bool) *reportsgtm.IpStatPerPropDRow {
+
+	row := &reportsgtm.IpStatPerPropDRow{DatacenterId: dcId, Nickname: "dc"}
+	for _, ip := range ips {
+		row.IPs = append(row.IPs, &reportsgtm.IpStatIp{Ip: "192.0.2.1", Alive: ip[0], HandedOut: ip[1], Score: 0.5})
+	}
+	return row
+}
+
+func TestSummarizeDatacenterHealth(t *testing.T) {
+
+	row := testIPStatusRow(3131, [2]bool{true, true}, [2]bool{false, false}, [2]bool{true, false})
+	row.IPs[1].Score = 1
+	want := &DatacenterHealth{DatacenterId: 3131, Nickname: "dc", IPs: 3, Alive: 2, HandedOut: 1, Score: 1}
+	if got := summarizeDatacenterHealth(row); !reflect.DeepEqual(got, want) {
+		t.Errorf("summarizeDatacenterHealth() = %+v, want %+v", got, want)
+	}
+}
+
+func TestRollupPropertyHealth(t *testing.T) {
+
+	tests := []struct {
+		name string
+		rows []*reportsgtm.IpStatPerPropDRow
+		want string
+	}{
+		{"no datacenters", nil, healthUnknown},
+		{"all alive", []*reportsgtm.IpStatPerPropDRow{
+			testIPStatusRow(3131, [2]bool{true, true}),
+			testIPStatusRow(3132, [2]bool{true, false}),
+		}, healthOK},
+		{"one datacenter down", []*reportsgtm.IpStatPerPropDRow{
+			testIPStatusRow(3131, [2]bool{true, true}),
+			testIPStatusRow(3132, [2]bool{false, false}),
+		}, healthDegraded},
+		{"one IP down", []*reportsgtm.IpStatPerPropDRow{
+			testIPStatusRow(3131, [2]bool{true, true}, [2]bool{false, true}),
+		}, healthDegraded},
+		{"alive IPs not handed out", []*reportsgtm.IpStatPerPropDRow{
+			testIPStatusRow(3131, [2]bool{false, true}),
+			testIPStatusRow(3132, [2]bool{true, false}),
+		}, healthDown},
+		{"all down", []*reportsgtm.IpStatPerPropDRow{
+			testIPStatusRow(3131, [2]bool{false, false}),
+			testIPStatusRow(3132, [2]bool{false, true}),
+		}, healthDown},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			propHealth := &PropertyHealth{Property: "www"}
+			for _, row := range tt.rows {
+				propHealth.Datacenters = append(propHealth.Datacenters, summarizeDatacenterHealth(row))
+			}
+			if got := rollupPropertyHealth(propHealth); got != tt.want {
+				t.Errorf("rollupPropertyHealth() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
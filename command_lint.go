@@ -0,0 +1,481 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	akamai "github.com/akamai/cli-common-golang"
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli"
+	"io/ioutil"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	severityInfo    = "info"
+	severityWarning = "warning"
+	severityError   = "error"
+)
+
+var severityRank = map[string]int{severityInfo: 0, severityWarning: 1, severityError: 2}
+
+// LintFinding represents a single rule violation
+type LintFinding struct {
+	Rule     string
+	Severity string
+	Object   string
+	Message  string
+}
+
+// LintReport is the lint result structure
+type LintReport struct {
+	Domain     string
+	Findings   []*LintFinding
+	Suppressed int
+	Passed     bool
+}
+
+// lintRule is a named domain check. New rules are added to lintRules.
+type lintRule struct {
+	name        string
+	severity    string
+	description string
+	check       func(dom *configgtm.Domain) []*LintFinding
+}
+
+var lintRules = []*lintRule{
+	{
+		name:        "enabled-target-zero-weight",
+		severity:    severityWarning,
+		description: "Enabled traffic target in a weighted property has weight 0",
+		check:       lintEnabledTargetZeroWeight,
+	},
+	{
+		name:        "no-liveness-tests",
+		severity:    severityWarning,
+		description: "Property has no liveness tests",
+		check:       lintNoLivenessTests,
+	},
+	{
+		name:        "all-targets-disabled",
+		severity:    severityError,
+		description: "Property has no enabled traffic targets",
+		check:       lintAllTargetsDisabled,
+	},
+	{
+		name:        "no-traffic-targets",
+		severity:    severityError,
+		description: "Property has no traffic targets",
+		check:       lintNoTrafficTargets,
+	},
+	{
+		name:        "duplicate-servers",
+		severity:    severityWarning,
+		description: "Server is listed in more than one traffic target of a property",
+		check:       lintDuplicateServers,
+	},
+	{
+		name:        "unreferenced-datacenter",
+		severity:    severityInfo,
+		description: "Datacenter is not referenced by any property or map",
+		check:       lintUnreferencedDatacenter,
+	},
+	{
+		name:        "inconsistent-liveness-tests",
+		severity:    severityWarning,
+		description: "Liveness tests with the same name differ between properties",
+		check:       lintInconsistentLivenessTests,
+	},
+}
+
+// findLintRule returns the rule with name
+func findLintRule(name string) *lintRule {
+
+	for _, rule := range lintRules {
+		if rule.name == name {
+			return rule
+		}
+	}
+	return nil
+}
+
+func propObject(prop *configgtm.Property) string {
+	return "property " + prop.Name
+}
+
+func lintEnabledTargetZeroWeight(dom *configgtm.Domain) []*LintFinding {
+
+	var findings []*LintFinding
+	for _, prop := range dom.Properties {
+		if !strings.Contains(prop.Type, "weighted") {
+			continue
+		}
+		for _, tt := range prop.TrafficTargets {
+			if tt.Enabled && tt.Weight == 0 {
+				findings = append(findings, &LintFinding{Object: propObject(prop), Message: fmt.Sprintf("datacenter %d target is enabled with weight 0", tt.DatacenterId)})
+			}
+		}
+	}
+	return findings
+}
+
+func lintNoLivenessTests(dom *configgtm.Domain) []*LintFinding {
+
+	var findings []*LintFinding
+	for _, prop := range dom.Properties {
+		if len(prop.LivenessTests) == 0 {
+			findings = append(findings, &LintFinding{Object: propObject(prop), Message: "property has no liveness tests"})
+		}
+	}
+	return findings
+}
+
+func lintAllTargetsDisabled(dom *configgtm.Domain) []*LintFinding {
+
+	var findings []*LintFinding
+	for _, prop := range dom.Properties {
+		// properties without targets are reported by no-traffic-targets
+		if len(prop.TrafficTargets) == 0 {
+			continue
+		}
+		enabled := 0
+		for _, tt := range prop.TrafficTargets {
+			if tt.Enabled {
+				enabled++
+			}
+		}
+		if enabled == 0 {
+			findings = append(findings, &LintFinding{Object: propObject(prop), Message: fmt.Sprintf("all %d traffic targets are disabled", len(prop.TrafficTargets))})
+		}
+	}
+	return findings
+}
+
+func lintNoTrafficTargets(dom *configgtm.Domain) []*LintFinding {
+
+	var findings []*LintFinding
+	for _, prop := range dom.Properties {
+		if len(prop.TrafficTargets) == 0 {
+			findings = append(findings, &LintFinding{Object: propObject(prop), Message: "property has no traffic targets"})
+		}
+	}
+	return findings
+}
+
+func lintDuplicateServers(dom *configgtm.Domain) []*LintFinding {
+
+	var findings []*LintFinding
+	for _, prop := range dom.Properties {
+		serverDCs := make(map[string][]string)
+		var servers []string
+		for _, tt := range prop.TrafficTargets {
+			for _, server := range tt.Servers {
+				if _, ok := serverDCs[server]; !ok {
+					servers = append(servers, server)
+				}
+				serverDCs[server] = append(serverDCs[server], strconv.Itoa(tt.DatacenterId))
+			}
+		}
+		for _, server := range servers {
+			if len(serverDCs[server]) > 1 {
+				findings = append(findings, &LintFinding{Object: propObject(prop), Message: fmt.Sprintf("server %s is listed in datacenter targets %s", server, strings.Join(serverDCs[server], ", "))})
+			}
+		}
+	}
+	return findings
+}
+
+func lintUnreferencedDatacenter(dom *configgtm.Domain) []*LintFinding {
+
+	var findings []*LintFinding
+	referenced := make(map[int]bool)
+	for _, prop := range dom.Properties {
+		for _, tt := range prop.TrafficTargets {
+			referenced[tt.DatacenterId] = true
+		}
+	}
+	refBase := func(base *configgtm.DatacenterBase) {
+		if base != nil {
+			referenced[base.DatacenterId] = true
+		}
+	}
+	for _, geo := range dom.GeographicMaps {
+		refBase(geo.DefaultDatacenter)
+		for _, a := range geo.Assignments {
+			referenced[a.DatacenterId] = true
+		}
+	}
+	for _, cidr := range dom.CidrMaps {
+		refBase(cidr.DefaultDatacenter)
+		for _, a := range cidr.Assignments {
+			referenced[a.DatacenterId] = true
+		}
+	}
+	for _, as := range dom.AsMaps {
+		refBase(as.DefaultDatacenter)
+		for _, a := range as.Assignments {
+			referenced[a.DatacenterId] = true
+		}
+	}
+	for _, dc := range dom.Datacenters {
+		if !referenced[dc.DatacenterId] {
+			findings = append(findings, &LintFinding{Object: fmt.Sprintf("datacenter %d", dc.DatacenterId), Message: fmt.Sprintf("datacenter %s is not referenced", dc.Nickname)})
+		}
+	}
+	return findings
+}
+
+// liveness test settings compared by lintInconsistentLivenessTests
+func livenessTestSignature(lt *configgtm.LivenessTest) string {
+
+	return fmt.Sprintf("protocol=%s object=%s port=%d interval=%d timeout=%g", lt.TestObjectProtocol, lt.TestObject, lt.TestObjectPort, lt.TestInterval, lt.TestTimeout)
+}
+
+func lintInconsistentLivenessTests(dom *configgtm.Domain) []*LintFinding {
+
+	var findings []*LintFinding
+	var names []string
+	signatures := make(map[string]map[string][]string)
+	for _, prop := range dom.Properties {
+		for _, lt := range prop.LivenessTests {
+			if _, ok := signatures[lt.Name]; !ok {
+				signatures[lt.Name] = make(map[string][]string)
+				names = append(names, lt.Name)
+			}
+			sig := livenessTestSignature(lt)
+			signatures[lt.Name][sig] = append(signatures[lt.Name][sig], prop.Name)
+		}
+	}
+	for _, name := range names {
+		if len(signatures[name]) < 2 {
+			continue
+		}
+		var variants []string
+		for sig, props := range signatures[name] {
+			variants = append(variants, fmt.Sprintf("[%s] in %s", sig, strings.Join(props, ", ")))
+		}
+		sort.Strings(variants)
+		findings = append(findings, &LintFinding{Object: "liveness test " + name, Message: "differs between properties: " + strings.Join(variants, "; ")})
+	}
+	return findings
+}
+
+// lintSuppression suppresses a rule for all objects or a specific object
+type lintSuppression struct {
+	rule   string
+	object string
+}
+
+// parseSuppressions parses rule or rule:object suppression specifications
+func parseSuppressions(specs []string) ([]*lintSuppression, error) {
+
+	var suppressions []*lintSuppression
+	for _, spec := range specs {
+		parts := strings.SplitN(spec, ":", 2)
+		if findLintRule(parts[0]) == nil {
+			return nil, fmt.Errorf("Unknown lint rule %s", parts[0])
+		}
+		supp := &lintSuppression{rule: parts[0]}
+		if len(parts) == 2 {
+			supp.object = parts[1]
+		}
+		suppressions = append(suppressions, supp)
+	}
+	return suppressions, nil
+}
+
+// suppressed returns true if finding matches a suppression. Object matches property, datacenter or liveness test name.
+func (f *LintFinding) suppressed(suppressions []*lintSuppression) bool {
+
+	for _, supp := range suppressions {
+		if supp.rule != f.Rule {
+			continue
+		}
+		if supp.object == "" || f.Object == supp.object || strings.HasSuffix(f.Object, " "+supp.object) {
+			return true
+		}
+	}
+	return false
+}
+
+// lintDomain runs all rules against domain
+func lintDomain(dom *configgtm.Domain, suppressions []*lintSuppression, failOn string) *LintReport {
+
+	report := &LintReport{Domain: dom.Name, Findings: []*LintFinding{}, Passed: true}
+	for _, rule := range lintRules {
+		for _, finding := range rule.check(dom) {
+			finding.Rule = rule.name
+			finding.Severity = rule.severity
+			if finding.suppressed(suppressions) {
+				report.Suppressed++
+				continue
+			}
+			report.Findings = append(report.Findings, finding)
+			if severityRank[finding.Severity] >= severityRank[failOn] {
+				report.Passed = false
+			}
+		}
+	}
+	sort.SliceStable(report.Findings, func(i, j int) bool {
+		return severityRank[report.Findings[i].Severity] > severityRank[report.Findings[j].Severity]
+	})
+	return report
+}
+
+// worker function for lint
+func cmdLint(c *cli.Context) error {
+
+	var dom *configgtm.Domain
+
+	if c.IsSet("verbose") {
+		verboseStatus = true
+	}
+	if c.Bool("list-rules") {
+		fmt.Fprintln(c.App.Writer, renderLintRules())
+		return nil
+	}
+	failOn := strings.ToLower(c.String("fail-on"))
+	if _, ok := severityRank[failOn]; !ok {
+		return cli.NewExitError(color.RedString("Invalid fail-on severity. Acceptable values: info, warning, error"), 1)
+	}
+	suppressions, err := parseSuppressions(c.StringSlice("suppress"))
+	if err != nil {
+		return cli.NewExitError(color.RedString(err.Error()), 1)
+	}
+
+	if c.IsSet("file") {
+		data, err := ioutil.ReadFile(c.String("file"))
+		if err != nil {
+			return cli.NewExitError(color.RedString("Unable to read domain file. "+err.Error()), 1)
+		}
+		dom = &configgtm.Domain{}
+		if err := json.Unmarshal(data, dom); err != nil {
+			return cli.NewExitError(color.RedString("Unable to parse domain file. "+err.Error()), 1)
+		}
+	} else {
+		if c.NArg() == 0 {
+			cli.ShowCommandHelp(c, c.Command.Name)
+			return cli.NewExitError(color.RedString("domain or file is required"), 1)
+		}
+		config, err := akamai.GetEdgegridConfig(c)
+		if err != nil {
+			return err
+		}
+		configgtm.Init(config)
+		domainName := c.Args().First()
+		if !c.IsSet("json") {
+			akamai.StartSpinner("Retrieving domain ", "")
+		}
+		dom, err = cachedDomain(c, domainName)
+		if err != nil {
+			if !c.IsSet("json") {
+				akamai.StopSpinnerFail()
+			}
+			return cli.NewExitError(color.RedString("Domain "+domainName+" not found "), 1)
+		}
+		if !c.IsSet("json") {
+			akamai.StopSpinnerOk()
+		}
+	}
+
+	report := lintDomain(dom, suppressions, failOn)
+
+	if c.IsSet("json") && c.Bool("json") {
+		json, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return cli.NewExitError(color.RedString("Unable to display lint results"), 1)
+		}
+		fmt.Fprintln(c.App.Writer, string(json))
+	} else {
+		fmt.Fprintln(c.App.Writer, renderLintTable(report))
+	}
+	if !report.Passed {
+		return cli.NewExitError(color.RedString(fmt.Sprintf("Lint failed. Finding(s) at or above severity %s", failOn)), 1)
+	}
+
+	return nil
+}
+
+// Pretty print lint findings
+func renderLintTable(report *LintReport) string {
+
+	var outString string
+	outString += fmt.Sprintln(" ")
+	outString += fmt.Sprintln(fmt.Sprintf("Domain: %s", report.Domain))
+	outString += fmt.Sprintln(fmt.Sprintf("Findings: %d, Suppressed: %d", len(report.Findings), report.Suppressed))
+	outString += fmt.Sprintln(" ")
+	tableString := &strings.Builder{}
+	table := tablewriter.NewWriter(tableString)
+
+	table.SetHeader([]string{"Severity", "Rule", "Object", "Message"})
+	table.SetReflowDuringAutoWrap(false)
+	table.SetCenterSeparator(" ")
+	table.SetColumnSeparator(" ")
+	table.SetRowSeparator(" ")
+	table.SetBorder(false)
+	table.SetAutoWrapText(false)
+	table.SetColumnAlignment([]int{tablewriter.ALIGN_LEFT, tablewriter.ALIGN_LEFT, tablewriter.ALIGN_LEFT, tablewriter.ALIGN_LEFT})
+	table.SetAlignment(tablewriter.ALIGN_CENTER)
+
+	if len(report.Findings) == 0 {
+		rowData := []string{"No findings", " ", " ", " "}
+		table.Append(rowData)
+	} else {
+		for _, f := range report.Findings {
+			sev := f.Severity
+			switch sev {
+			case severityError:
+				sev = color.RedString(sev)
+			case severityWarning:
+				sev = color.YellowString(sev)
+			}
+			rowData := []string{sev, f.Rule, f.Object, f.Message}
+			table.Append(rowData)
+		}
+	}
+	table.Render()
+	outString += fmt.Sprintln(tableString.String())
+
+	return outString
+}
+
+// Pretty print available lint rules
+func renderLintRules() string {
+
+	tableString := &strings.Builder{}
+	table := tablewriter.NewWriter(tableString)
+
+	table.SetHeader([]string{"Rule", "Severity", "Description"})
+	table.SetReflowDuringAutoWrap(false)
+	table.SetCenterSeparator(" ")
+	table.SetColumnSeparator(" ")
+	table.SetRowSeparator(" ")
+	table.SetBorder(false)
+	table.SetAutoWrapText(false)
+	table.SetColumnAlignment([]int{tablewriter.ALIGN_LEFT, tablewriter.ALIGN_LEFT, tablewriter.ALIGN_LEFT})
+	table.SetAlignment(tablewriter.ALIGN_CENTER)
+
+	for _, rule := range lintRules {
+		table.Append([]string{rule.name, rule.severity, rule.description})
+	}
+	table.Render()
+
+	return tableString.String()
+}
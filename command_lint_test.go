@@ -0,0 +1,136 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestLintRules(t *testing.T) {
+
+	httpTest := func(port int) *configgtm.LivenessTest {
+		return &configgtm.LivenessTest{Name: "http", TestObjectProtocol: "HTTP", TestObject: "/", TestObjectPort: port, TestInterval: 60, TestTimeout: 10}
+	}
+	tests := []struct {
+		name string
+		rule string
+		dom  *configgtm.Domain
+		want []string
+	}{
+		{"zero weight enabled target", "enabled-target-zero-weight", &configgtm.Domain{Properties: []*configgtm.Property{
+			{Name: "www", Type: "weighted-round-robin", TrafficTargets: []*configgtm.TrafficTarget{{DatacenterId: 3131, Enabled: true, Weight: 0}, {DatacenterId: 3132, Enabled: true, Weight: 1}}},
+		}}, []string{"property www: datacenter 3131 target is enabled with weight 0"}},
+		{"zero weight ignored for failover", "enabled-target-zero-weight", &configgtm.Domain{Properties: []*configgtm.Property{
+			{Name: "www", Type: "failover", TrafficTargets: []*configgtm.TrafficTarget{{DatacenterId: 3131, Enabled: true, Weight: 0}}},
+		}}, nil},
+		{"zero weight disabled target", "enabled-target-zero-weight", &configgtm.Domain{Properties: []*configgtm.Property{
+			{Name: "www", Type: "weighted-round-robin", TrafficTargets: []*configgtm.TrafficTarget{{DatacenterId: 3131, Weight: 0}}},
+		}}, nil},
+		{"no liveness tests", "no-liveness-tests", &configgtm.Domain{Properties: []*configgtm.Property{
+			{Name: "www"}, {Name: "api", LivenessTests: []*configgtm.LivenessTest{httpTest(80)}},
+		}}, []string{"property www: property has no liveness tests"}},
+		{"all targets disabled", "all-targets-disabled", &configgtm.Domain{Properties: []*configgtm.Property{
+			{Name: "www", TrafficTargets: []*configgtm.TrafficTarget{{DatacenterId: 3131}, {DatacenterId: 3132}}},
+			{Name: "api", TrafficTargets: []*configgtm.TrafficTarget{{DatacenterId: 3131}, {DatacenterId: 3132, Enabled: true}}},
+		}}, []string{"property www: all 2 traffic targets are disabled"}},
+		{"all targets disabled skips property without targets", "all-targets-disabled", &configgtm.Domain{Properties: []*configgtm.Property{
+			{Name: "www"},
+		}}, nil},
+		{"no traffic targets", "no-traffic-targets", &configgtm.Domain{Properties: []*configgtm.Property{
+			{Name: "www"}, {Name: "api", TrafficTargets: []*configgtm.TrafficTarget{{DatacenterId: 3131}}},
+		}}, []string{"property www: property has no traffic targets"}},
+		{"duplicate servers", "duplicate-servers", &configgtm.Domain{Properties: []*configgtm.Property{
+			{Name: "www", TrafficTargets: []*configgtm.TrafficTarget{
+				{DatacenterId: 3131, Servers: []string{"192.0.2.1", "192.0.2.2"}},
+				{DatacenterId: 3132, Servers: []string{"192.0.2.1"}},
+			}},
+			{Name: "api", TrafficTargets: []*configgtm.TrafficTarget{{DatacenterId: 3131, Servers: []string{"192.0.2.1"}}}},
+		}}, []string{"property www: server 192.0.2.1 is listed in datacenter targets 3131, 3132"}},
+		{"unreferenced datacenter", "unreferenced-datacenter", &configgtm.Domain{
+			Datacenters: []*configgtm.Datacenter{{DatacenterId: 3131, Nickname: "east"}, {DatacenterId: 3132, Nickname: "west"}, {DatacenterId: 3133, Nickname: "central"}, {DatacenterId: 3134, Nickname: "spare"}},
+			Properties:  []*configgtm.Property{{Name: "www", TrafficTargets: []*configgtm.TrafficTarget{{DatacenterId: 3131}}}},
+			GeographicMaps: []*configgtm.GeoMap{{Name: "geo", DefaultDatacenter: &configgtm.DatacenterBase{DatacenterId: 3132},
+				Assignments: []*configgtm.GeoAssignment{{DatacenterBase: configgtm.DatacenterBase{DatacenterId: 3133}}}}},
+		}, []string{"datacenter 3134: datacenter spare is not referenced"}},
+		{"inconsistent liveness tests", "inconsistent-liveness-tests", &configgtm.Domain{Properties: []*configgtm.Property{
+			{Name: "www", LivenessTests: []*configgtm.LivenessTest{httpTest(80)}},
+			{Name: "api", LivenessTests: []*configgtm.LivenessTest{httpTest(8080)}},
+			{Name: "img", LivenessTests: []*configgtm.LivenessTest{httpTest(80)}},
+		}}, []string{"liveness test http: differs between properties: [protocol=HTTP object=/ port=80 interval=60 timeout=10] in www, img; [protocol=HTTP object=/ port=8080 interval=60 timeout=10] in api"}},
+		{"consistent liveness tests", "inconsistent-liveness-tests", &configgtm.Domain{Properties: []*configgtm.Property{
+			{Name: "www", LivenessTests: []*configgtm.LivenessTest{httpTest(80)}},
+			{Name: "api", LivenessTests: []*configgtm.LivenessTest{httpTest(80)}},
+		}}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rule := findLintRule(tt.rule)
+			if rule == nil {
+				t.Fatalf("rule %s not found", tt.rule)
+			}
+			var got []string
+			for _, finding := range rule.check(tt.dom) {
+				got = append(got, finding.Object+": "+finding.Message)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("findings %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLintDomain(t *testing.T) {
+
+	dom := &configgtm.Domain{Name: "example.akadns.net", Properties: []*configgtm.Property{
+		{Name: "www", TrafficTargets: []*configgtm.TrafficTarget{{DatacenterId: 3131, Enabled: true}}},
+		{Name: "api"},
+	}}
+	tests := []struct {
+		name       string
+		suppress   []string
+		failOn     string
+		rules      []string
+		suppressed int
+		passed     bool
+	}{
+		{"errors sorted first", nil, severityError, []string{"no-traffic-targets", "no-liveness-tests", "no-liveness-tests"}, 0, false},
+		{"suppress rule for object", []string{"no-liveness-tests:www"}, severityError, []string{"no-traffic-targets", "no-liveness-tests"}, 1, false},
+		{"suppress errors", []string{"no-traffic-targets"}, severityError, []string{"no-liveness-tests", "no-liveness-tests"}, 1, true},
+		{"fail on warning", []string{"no-traffic-targets"}, severityWarning, []string{"no-liveness-tests", "no-liveness-tests"}, 1, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			suppressions, err := parseSuppressions(tt.suppress)
+			if err != nil {
+				t.Fatal(err)
+			}
+			report := lintDomain(dom, suppressions, tt.failOn)
+			var rules []string
+			for _, finding := range report.Findings {
+				rules = append(rules, finding.Rule)
+			}
+			if !reflect.DeepEqual(rules, tt.rules) || report.Suppressed != tt.suppressed || report.Passed != tt.passed {
+				t.Errorf("rules %v suppressed %d passed %v, want %v %d %v", rules, report.Suppressed, report.Passed, tt.rules, tt.suppressed, tt.passed)
+			}
+		})
+	}
+
+	if _, err := parseSuppressions([]string{"no-such-rule"}); err == nil || !strings.Contains(err.Error(), "Unknown lint rule no-such-rule") {
+		t.Errorf("error = %v, want unknown lint rule", err)
+	}
+}
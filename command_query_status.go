@@ -16,9 +16,13 @@ package main
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"regexp"
+	"sort"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
@@ -30,11 +34,16 @@ import (
 )
 
 var domainName string
-var qsProperty string
+var qsProperties []string
 var qsDatacenters *arrayFlags
-var statusPeriodLen = "15m"
+var qsDomain *configgtm.Domain
+var qsPeriod *reportPeriod
+var qsHighlighter *cellHighlighter
 var qsNicknames []string
 
+// reports queried at once
+const reportConcurrency int = 8
+
 // DCTrafficStati  represents Data Center Traffic Status returned structure. Contains a list of individual DC stati.
 type DCTrafficStati struct {
 	Domain             string
@@ -73,6 +82,7 @@ type PropertyStatus struct {
 	ReportInterval           string
 	StatusSummary            *PropertyStatusSummary
 	DatacenterIntervalStatus []*reportsgtm.PropertyTData
+	Error                    string `json:",omitempty"`
 }
 
 // PropertyStatusSummary represents Property IP Status Summary struct
@@ -91,17 +101,11 @@ type PropertyDCStatus struct {
 	DCWeight              float64
 }
 
-var defaultPeriod time.Duration = 15 * 60 * 1000 * 1000
-
-// Calc period start and end. Input string specifying duration, e.g. 15m. Returns formatted strings consumable by GTM Reports API.
-func calcPeriodStartandEnd(trafficType string, periodLen string) (string, string, error) {
-
-	dur, err := time.ParseDuration(periodLen)
-	if err != nil {
-		dur = defaultPeriod
-	}
+// Calc period start and end of the requested period. Returns formatted strings consumable by GTM Reports API.
+func calcPeriodStartandEnd(trafficType string, period *reportPeriod) (string, string, error) {
 
 	var window *reportsgtm.WindowResponse
+	var err error
 
 	if trafficType == "datacenter" {
 		window, err = reportsgtm.GetDatacentersTrafficWindow()
@@ -109,19 +113,22 @@ func calcPeriodStartandEnd(trafficType string, periodLen string) (string, string
 		window, err = reportsgtm.GetPropertiesTrafficWindow()
 	} else {
 		// shouldn't get here. If so, return invalid date
-		err := configgtm.CommonError{}
-		err.SetItem("entityName", "Window")
-		err.SetItem("name", "Data Window")
-		err.SetItem("apiErrorMessage", "Traffic Type "+trafficType+" not supported")
+		cerr := configgtm.CommonError{}
+		cerr.SetItem("entityName", "Window")
+		cerr.SetItem("name", "Data Window")
+		cerr.SetItem("apiErrorMessage", "Traffic Type "+trafficType+" not supported")
+		err = cerr
 	}
 	if err != nil {
 		// return invalid dates
 		return "", "", err
 	}
-	end := window.EndTime
-	start := end.Add(-dur)
+	start, end, err := period.resolve(window)
+	if err != nil {
+		return "", "", err
+	}
 
-	return start.Format(time.RFC3339), end.Format(time.RFC3339), nil
+	return start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339), nil
 
 }
 
@@ -189,10 +196,7 @@ func findDatacenterInDomain(domain *configgtm.Domain, dcID int) (*configgtm.Data
 func populateEmptyDCStatusList() ([]*DCStatusDetail, error) {
 
 	var dcStatDetailList []*DCStatusDetail
-	dom, err := configgtm.GetDomain(domainName)
-	if err != nil {
-		return dcStatDetailList, err
-	}
+	dom := qsDomain
 	for _, dcID := range qsDatacenters.flagList {
 		dcEntry := &DCStatusDetail{DatacenterId: dcID} // Do we need the nickname also?
 		if dc, ok := findDatacenterInDomain(dom, dcID); ok {
@@ -210,15 +214,12 @@ func gatherDatacenterStatus() (*DCTrafficStati, error) {
 
 	dcTrafficStati := &DCTrafficStati{Domain: domainName}
 	// calc period start and end
-	pstart, pend, err := calcPeriodStartandEnd("datacenter", statusPeriodLen)
-	if err != nil {
-		return nil, err
-	}
-	// get the domain struct. Will need as cycle thru DCs
-	dom, err := configgtm.GetDomain(domainName)
+	pstart, pend, err := calcPeriodStartandEnd("datacenter", qsPeriod)
 	if err != nil {
 		return nil, err
 	}
+	// domain struct. Will need as cycle thru DCs
+	dom := qsDomain
 	dcTrafficStati.PeriodStart = pstart
 	dcTrafficStati.PeriodEnd = pend
 	optArgs := make(map[string]string)
@@ -293,18 +294,13 @@ func getDomainStatus() (*configgtm.ResponseStatus, error) {
 }
 
 // Retrieve Status and DC status for property
-func gatherPropertyStatus() (*PropertyStatus, error) {
+func gatherPropertyStatus(propName string, pstart string, pend string) (*PropertyStatus, error) {
 
-	propStat := &PropertyStatus{PropertyName: qsProperty}
-	// calc traffic period start and end
-	pstart, pend, err := calcPeriodStartandEnd("property", statusPeriodLen)
-	if err != nil {
-		return nil, err
-	}
+	propStat := &PropertyStatus{PropertyName: propName}
 	optArgs := make(map[string]string)
 	// Retrieve IP Availability status
 	optArgs["mostRecent"] = "true"
-	propertyIpAvail, err := reportsgtm.GetIpStatusPerProperty(domainName, qsProperty, optArgs)
+	propertyIpAvail, err := reportsgtm.GetIpStatusPerProperty(domainName, propName, optArgs)
 	if err != nil {
 		return nil, err
 	}
@@ -313,7 +309,7 @@ func gatherPropertyStatus() (*PropertyStatus, error) {
 	delete(optArgs, "mostRecent")
 	optArgs["start"] = pstart
 	optArgs["end"] = pend
-	propertyTraffic, err = reportsgtm.GetTrafficPerProperty(domainName, qsProperty, optArgs)
+	propertyTraffic, err = reportsgtm.GetTrafficPerProperty(domainName, propName, optArgs)
 	if err != nil {
 		return nil, err
 	}
@@ -345,18 +341,22 @@ func gatherPropertyStatus() (*PropertyStatus, error) {
 		ttWeight   float64
 	}
 	ttEnabledMap := make(map[int]trafficTargetEnabledStatus)
-	prop, err := configgtm.GetProperty(qsProperty, domainName)
-	// if error, can't find disabled targets ... results in incomplete set
-	if err != nil {
-		return nil, err
+	var prop *configgtm.Property
+	for _, p := range qsDomain.Properties {
+		if p.Name == propName {
+			prop = p
+		}
+	}
+	// if not found, can't find disabled targets ... results in incomplete set
+	if prop == nil {
+		return nil, fmt.Errorf("Property %s not found in domain %s", propName, domainName)
 	}
 	for _, tgt := range prop.TrafficTargets {
 		// collect enabled status for later use
 		ttMapEntry := trafficTargetEnabledStatus{ttName: tgt.Name, ttEnabled: tgt.Enabled,
 			ttWeight: tgt.Weight}
 		// need info from DC, e.g. nickname
-		dc, err := configgtm.GetDatacenter(tgt.DatacenterId, domainName)
-		if err == nil {
+		if dc, ok := findDatacenterInDomain(qsDomain, tgt.DatacenterId); ok {
 			ttMapEntry.ttNickname = dc.Nickname
 		}
 		ttEnabledMap[tgt.DatacenterId] = ttMapEntry
@@ -441,6 +441,96 @@ func gatherPropertyStatus() (*PropertyStatus, error) {
 
 }
 
+// Retrieve status of multiple properties concurrently. Properties whose status can't be retrieved include the error.
+func gatherPropertiesStatus() ([]*PropertyStatus, error) {
+
+	// calc traffic period start and end once for all properties
+	pstart, pend, err := calcPeriodStartandEnd("property", qsPeriod)
+	if err != nil {
+		return nil, err
+	}
+	propStats := make([]*PropertyStatus, len(qsProperties))
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, reportConcurrency)
+	for i, propName := range qsProperties {
+		wg.Add(1)
+		go func(i int, propName string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			propStat, err := gatherPropertyStatus(propName, pstart, pend)
+			if err != nil {
+				propStat = &PropertyStatus{Domain: domainName, PropertyName: propName, Error: err.Error()}
+			}
+			propStats[i] = propStat
+		}(i, propName)
+	}
+	wg.Wait()
+
+	for _, propStat := range propStats {
+		if propStat.Error == "" {
+			return propStats, nil
+		}
+	}
+	return nil, errors.New(propStats[0].Error)
+}
+
+// selectProperties returns the domain properties selected by property, property-regex and all-properties
+func selectProperties(c *cli.Context, dom *configgtm.Domain) ([]string, error) {
+
+	var names []string
+	for _, p := range dom.Properties {
+		names = append(names, p.Name)
+	}
+	sort.Strings(names)
+	if c.Bool("all-properties") {
+		return names, nil
+	}
+	var selected, problems []string
+	seen := make(map[string]bool)
+	for _, propName := range c.StringSlice("property") {
+		found := false
+		for _, name := range names {
+			if name == propName {
+				found = true
+				break
+			}
+		}
+		if !found {
+			problems = append(problems, fmt.Sprintf("property %s not found%s", propName, suggestionText(closestMatches(names, propName))))
+			continue
+		}
+		if !seen[propName] {
+			seen[propName] = true
+			selected = append(selected, propName)
+		}
+	}
+	for _, pattern := range c.StringSlice("property-regex") {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid property pattern %s. %s", pattern, err.Error())
+		}
+		matched := false
+		for _, name := range names {
+			if !re.MatchString(name) {
+				continue
+			}
+			matched = true
+			if !seen[name] {
+				seen[name] = true
+				selected = append(selected, name)
+			}
+		}
+		if !matched {
+			problems = append(problems, fmt.Sprintf("no properties match %s", pattern))
+		}
+	}
+	if len(problems) > 0 {
+		return nil, fmt.Errorf("Unable to select properties in domain %s: %s", dom.Name, strings.Join(problems, "; "))
+	}
+	return selected, nil
+}
+
 // worker function for query-status
 func cmdQueryStatus(c *cli.Context) error {
 
@@ -458,81 +548,206 @@ func cmdQueryStatus(c *cli.Context) error {
 	}
 
 	domainName = c.Args().Get(0)
+	interval := qsWatch.interval
+	if c.IsSet("watch") && c.NArg() > 1 {
+		// --watch <interval> <domain>. Flag parsing stops at the interval.
+		if interval, err = parseWatchInterval(c.Args().Get(0)); err != nil {
+			return cli.NewExitError(color.RedString(err.Error()), 1)
+		}
+		for _, arg := range c.Args().Tail() {
+			if strings.HasPrefix(arg, "-") {
+				return cli.NewExitError(color.RedString("Flags may not follow --watch <interval>. Specify --watch=<interval>"), 1)
+			}
+		}
+		domainName = c.Args().Get(1)
+	}
 
-	qsProperty = c.String("property")
 	qsDatacenters = (c.Generic("datacenter")).(*arrayFlags)
 	if c.IsSet("verbose") {
 		verboseStatus = true
 	}
 
-	if c.IsSet("property") && c.IsSet("datacenter") {
+	if propertiesSelected(c) && c.IsSet("datacenter") {
 		return cli.NewExitError(color.RedString("property OR datacenter(s) must be specified"), 1)
 	}
-	err = ParseNicknames(qsDatacenters.nicknamesList, domainName)
+	if c.IsSet("chart") && !propertiesSelected(c) && !c.IsSet("datacenter") {
+		return cli.NewExitError(color.RedString("property OR datacenter(s) must be specified with chart"), 1)
+	}
+	if c.IsSet("chart") && c.Bool("json") {
+		return cli.NewExitError(color.RedString("json and chart may not both be specified"), 1)
+	}
+	qsPeriod, err = parseReportPeriod(c, defaultPeriod)
 	if err != nil {
-		if verboseStatus {
-			return cli.NewExitError(color.RedString("Unable to retrieve datacenter list. "+err.Error()), 1)
-		} else {
-			return cli.NewExitError(color.RedString("Unable to retrieve datacenter."), 1)
+		return cli.NewExitError(color.RedString(err.Error()), 1)
+	}
+	// property and datacenter status need the domain configuration. Retrieved once per run.
+	if propertiesSelected(c) || c.IsSet("datacenter") {
+		qsDomain, err = configgtm.GetDomain(domainName)
+		if err != nil {
+			return cli.NewExitError(color.RedString("Domain "+domainName+" not found "), 1)
+		}
+	}
+	if propertiesSelected(c) {
+		qsProperties, err = selectProperties(c, qsDomain)
+		if err != nil {
+			return cli.NewExitError(color.RedString(err.Error()), 1)
+		}
+		if len(qsProperties) == 0 {
+			return cli.NewExitError(color.RedString("Domain "+domainName+" has no properties"), 1)
 		}
 	}
+	// resolve datacenter ids and nicknames
+	err = ResolveDatacenters(c, qsDatacenters, domainName, true)
+	if err != nil {
+		return cli.NewExitError(color.RedString(err.Error()), 1)
+	}
 	if !c.IsSet("json") {
 		fmt.Println("Querying status")
 	}
+	if interval > 0 {
+		return watchStatus(c, interval)
+	}
 
-	var objStatus interface{}
-
-	if c.IsSet("datacenter") {
-		if !c.IsSet("json") {
+	if !c.IsSet("json") {
+		if c.IsSet("datacenter") {
 			akamai.StartSpinner("Collecting DC status ", "")
-		}
-		objStatus, err = gatherDatacenterStatus()
-	} else if c.IsSet("property") {
-		if !c.IsSet("json") {
+		} else if propertiesSelected(c) {
 			akamai.StartSpinner("Collecting Property status ", "")
-		}
-		objStatus, err = gatherPropertyStatus()
-	} else {
-		if !c.IsSet("json") {
+		} else {
 			akamai.StartSpinner("Collecting Domain status ", "")
 		}
-		objStatus, err = getDomainStatus()
 	}
+	objStatus, err := queryStatus(c)
 	// check for failure
 	if err != nil {
 		akamai.StopSpinnerFail()
-		if verboseStatus {
-			return cli.NewExitError(color.RedString("Unable to retrieve status. "+err.Error()), 1)
-		} else {
-			return cli.NewExitError(color.RedString("Unable to retrieve status."), 1)
-		}
+		return cli.NewExitError(color.RedString(statusErrorText(err)), 1)
+	}
+	output, err := renderQueryStatus(objStatus, c)
+	if err != nil {
+		return cli.NewExitError(color.RedString(err.Error()), 1)
+	}
+	if !c.IsSet("json") {
+		akamai.StopSpinnerOk()
+		fmt.Fprintln(c.App.Writer, "")
 	}
+	fmt.Fprint(c.App.Writer, output)
 
-	if c.IsSet("json") && c.Bool("json") {
-		json, err := json.MarshalIndent(objStatus, "", "  ")
+	return nil
+
+}
+
+// Retrieve domain, property or datacenter status. Property and datacenter status use the domain configuration in qsDomain.
+func queryStatus(c *cli.Context) (interface{}, error) {
+
+	if c.IsSet("datacenter") {
+		return gatherDatacenterStatus()
+	} else if propertiesSelected(c) {
+		if !singlePropertySelected(c) {
+			return gatherPropertiesStatus()
+		}
+		// calc traffic period start and end
+		pstart, pend, err := calcPeriodStartandEnd("property", qsPeriod)
 		if err != nil {
-			return cli.NewExitError(color.RedString("Unable to display status results"), 1)
+			return nil, err
 		}
-		fmt.Fprintln(c.App.Writer, string(json))
-	} else {
-		akamai.StopSpinnerOk()
+		return gatherPropertyStatus(qsProperties[0], pstart, pend)
+	}
+	return getDomainStatus()
+}
 
-		fmt.Fprintln(c.App.Writer, "")
-		if c.IsSet("datacenter") {
+// propertiesSelected returns true if property, property-regex or all-properties is specified
+func propertiesSelected(c *cli.Context) bool {
+
+	return c.IsSet("property") || c.IsSet("property-regex") || c.Bool("all-properties")
+}
 
-			fmt.Fprintln(c.App.Writer, renderDatacenterTable(objStatus.(*DCTrafficStati), c))
+// singlePropertySelected returns true if a single property is specified. Multiple properties are reported as a list.
+func singlePropertySelected(c *cli.Context) bool {
 
-		} else if c.IsSet("property") {
+	return len(c.StringSlice("property")) == 1 && !c.IsSet("property-regex") && !c.Bool("all-properties")
+}
 
-			fmt.Fprintln(c.App.Writer, renderPropertyTable(objStatus.(*PropertyStatus), c))
+// Render status as JSON or table
+func renderQueryStatus(objStatus interface{}, c *cli.Context) (string, error) {
 
-		} else {
-			fmt.Fprintln(c.App.Writer, renderDomainTable(objStatus.(*configgtm.ResponseStatus), c))
+	if c.IsSet("json") && c.Bool("json") {
+		json, err := json.MarshalIndent(objStatus, "", "  ")
+		if err != nil {
+			return "", errors.New("Unable to display status results")
 		}
+		return fmt.Sprintln(string(json)), nil
 	}
+	var outString string
+	switch objStatus := objStatus.(type) {
+	case *DCTrafficStati:
+		outString = fmt.Sprintln(renderDatacenterTable(objStatus, c))
+	case *PropertyStatus:
+		outString = fmt.Sprintln(renderPropertyTable(objStatus, c))
+	case []*PropertyStatus:
+		outString = fmt.Sprintln(renderPropertiesTable(objStatus, c))
+	default:
+		return fmt.Sprintln(renderDomainTable(objStatus.(*configgtm.ResponseStatus), c)), nil
+	}
+	if chartFlags.style != "" {
+		outString += fmt.Sprintln("Requests")
+		outString += fmt.Sprintln(" ")
+		outString += fmt.Sprintln(renderChart(statusChartData(objStatus), chartFlags.style, qsPeriod.displayTime))
+	}
+	return outString, nil
+}
 
-	return nil
+// statusChartData returns the request series of datacenter status by datacenter, of property status by datacenter
+// and of multiple property status by property
+func statusChartData(objStatus interface{}) *chartData {
+
+	data := newChartData()
+	switch objStatus := objStatus.(type) {
+	case *DCTrafficStati:
+		for _, dc := range objStatus.StatusByDatacenter {
+			label := dc.DatacenterNickname
+			if label == "" {
+				label = strconv.Itoa(dc.DatacenterId)
+			}
+			for _, dcprop := range dc.DCStatusByProperty {
+				var requests int64
+				for _, prop := range dcprop.Properties {
+					requests += prop.Requests
+				}
+				data.add(label, dcprop.Timestamp, requests)
+			}
+		}
+	case *PropertyStatus:
+		for _, dcis := range objStatus.DatacenterIntervalStatus {
+			for _, dc := range dcis.Datacenters {
+				label := dc.Nickname
+				if label == "" {
+					label = strconv.Itoa(dc.DatacenterId)
+				}
+				data.add(label, dcis.Timestamp, dc.Requests)
+			}
+		}
+	case []*PropertyStatus:
+		for _, propStat := range objStatus {
+			for _, dcis := range propStat.DatacenterIntervalStatus {
+				var requests int64
+				for _, dc := range dcis.Datacenters {
+					requests += dc.Requests
+				}
+				data.add(propStat.PropertyName, dcis.Timestamp, requests)
+			}
+		}
+	}
+	return data
+}
 
+// Status retrieval failure message
+func statusErrorText(err error) string {
+
+	if verboseStatus {
+		return "Unable to retrieve status. " + err.Error()
+	}
+	return "Unable to retrieve status."
 }
 
 // Generate pretty print DC status
@@ -540,8 +755,8 @@ func renderDatacenterTable(objStatus *DCTrafficStati, c *cli.Context) string {
 
 	var outString string
 	outString += fmt.Sprintln("Domain: ", objStatus.Domain)
-	outString += fmt.Sprintln("Period Start: ", objStatus.PeriodStart)
-	outString += fmt.Sprintln("Period End: ", objStatus.PeriodEnd)
+	outString += fmt.Sprintln("Period Start: ", qsPeriod.displayTime(objStatus.PeriodStart))
+	outString += fmt.Sprintln("Period End: ", qsPeriod.displayTime(objStatus.PeriodEnd))
 	outString += fmt.Sprintln(" ")
 	tableString := &strings.Builder{}
 	table := tablewriter.NewWriter(tableString)
@@ -567,7 +782,7 @@ func renderDatacenterTable(objStatus *DCTrafficStati, c *cli.Context) string {
 			for pk, dcprop := range dc.DCStatusByProperty {
 				for k, prop := range dcprop.Properties {
 					if k == 0 {
-						dcptl = dcprop.Timestamp
+						dcptl = qsPeriod.displayTime(dcprop.Timestamp)
 						if pk == 0 {
 							dclid = strconv.Itoa(dc.DatacenterId)
 							dcln = dc.DatacenterNickname
@@ -580,8 +795,10 @@ func renderDatacenterTable(objStatus *DCTrafficStati, c *cli.Context) string {
 						dclid = " "
 						dcln = " "
 					}
+					// cells are identified by interval relative to the latest
+					key := fmt.Sprintf("dc/%d/%d/%s/", dc.DatacenterId, len(dc.DCStatusByProperty)-pk, prop.Name)
 					rowData := []string{dclid, dcln,
-						dcptl, prop.Name, strconv.FormatBool(prop.Enabled), strconv.FormatInt(prop.Requests, 10), prop.Status}
+						dcptl, prop.Name, qsHighlighter.cell(key+"enabled", strconv.FormatBool(prop.Enabled)), qsHighlighter.cell(key+"requests", strconv.FormatInt(prop.Requests, 10)), qsHighlighter.cell(key+"status", prop.Status)}
 					table.Append(rowData)
 				}
 			}
@@ -600,14 +817,14 @@ func renderPropertyTable(objStatus *PropertyStatus, c *cli.Context) string {
 	var outString string
 	outString += fmt.Sprintln("Domain: ", objStatus.Domain)
 	outString += fmt.Sprintln("Property: ", objStatus.PropertyName)
-	outString += fmt.Sprintln("Period Start: ", objStatus.PeriodStart)
-	outString += fmt.Sprintln("Period End: ", objStatus.PeriodEnd)
+	outString += fmt.Sprintln("Period Start: ", qsPeriod.displayTime(objStatus.PeriodStart))
+	outString += fmt.Sprintln("Period End: ", qsPeriod.displayTime(objStatus.PeriodEnd))
 	outString += fmt.Sprintln(" ")
 
 	// Build Summary Table
 	tableString := &strings.Builder{}
 	table := tablewriter.NewWriter(tableString)
-	outString += fmt.Sprintln("Status Summary -- Last Update: ", objStatus.StatusSummary.LastUpdate, ", CutOff: ", objStatus.StatusSummary.CutOff)
+	outString += fmt.Sprintln("Status Summary -- Last Update: ", qsPeriod.displayTime(objStatus.StatusSummary.LastUpdate), ", CutOff: ", objStatus.StatusSummary.CutOff)
 	outString += fmt.Sprintln(" ")
 	table.SetHeader([]string{"Datacenter", "Nickname", "Target Name", "Enabled", "Weight", "Total Requests", "Property Usage", "IP", "State"})
 	table.SetReflowDuringAutoWrap(false)
@@ -636,14 +853,16 @@ func renderPropertyTable(objStatus *PropertyStatus, c *cli.Context) string {
 			dcln = dc.Nickname
 			dclid = strconv.Itoa(dc.DatacenterId)
 			dctn = dc.TrafficTargetName
-			dcpperc = dc.DCPropertyUsage
-			dcenabled = strconv.FormatBool(dc.DCEnabled)
-			dcweight = strconv.FormatFloat(dc.DCWeight, 'f', 1, 64)
-			dcptr = strconv.FormatInt(dc.DCTotalPeriodRequests, 10)
+			tkey := fmt.Sprintf("target/%d/", dc.DatacenterId)
+			dcpperc = qsHighlighter.cell(tkey+"usage", dc.DCPropertyUsage)
+			dcenabled = qsHighlighter.cell(tkey+"enabled", strconv.FormatBool(dc.DCEnabled))
+			dcweight = qsHighlighter.cell(tkey+"weight", strconv.FormatFloat(dc.DCWeight, 'f', 1, 64))
+			dcptr = qsHighlighter.cell(tkey+"requests", strconv.FormatInt(dc.DCTotalPeriodRequests, 10))
 			if len(dc.IPs) < 1 {
 				dc.IPs = []*reportsgtm.IpStatIp{&reportsgtm.IpStatIp{}}
 			}
 			for k, ip := range dc.IPs {
+				key := fmt.Sprintf("%s%s/", tkey, ip.Ip)
 				if k == 0 {
 					dcip = ip.Ip
 				} else {
@@ -656,11 +875,11 @@ func renderPropertyTable(objStatus *PropertyStatus, c *cli.Context) string {
 					dcenabled = " "
 					dcweight = " "
 				}
-				rowData := []string{dclid, dcln, dctn, dcenabled, dcweight, dcptr, dcpperc, dcip, fmt.Sprintf("HandedOut: %s", strconv.FormatBool(ip.HandedOut))}
+				rowData := []string{dclid, dcln, dctn, dcenabled, dcweight, dcptr, dcpperc, dcip, qsHighlighter.cell(key+"handedout", fmt.Sprintf("HandedOut: %s", strconv.FormatBool(ip.HandedOut)))}
 				table.Append(rowData)
-				rowData = []string{"", "", "", "", "", "", " ", fmt.Sprintf("Score: %s", fmt.Sprintf("%.2f", ip.Score))}
+				rowData = []string{"", "", "", "", "", "", " ", qsHighlighter.cell(key+"score", fmt.Sprintf("Score: %s", fmt.Sprintf("%.2f", ip.Score)))}
 				table.Append(rowData)
-				rowData = []string{"", "", "", "", "", "", " ", fmt.Sprintf("Alive: %s", strconv.FormatBool(ip.Alive))}
+				rowData = []string{"", "", "", "", "", "", " ", qsHighlighter.cell(key+"alive", fmt.Sprintf("Alive: %s", strconv.FormatBool(ip.Alive)))}
 				table.Append(rowData)
 			}
 		}
@@ -692,17 +911,19 @@ func renderPropertyTable(objStatus *PropertyStatus, c *cli.Context) string {
 		rowData := []string{"No datacenter interval status available", " ", " ", " ", " "}
 		dcTable.Append(rowData)
 	} else {
-		for _, dcis := range objStatus.DatacenterIntervalStatus {
-			dcptl = dcis.Timestamp
+		for ik, dcis := range objStatus.DatacenterIntervalStatus {
+			dcptl = qsPeriod.displayTime(dcis.Timestamp)
 			for k, dc := range dcis.Datacenters {
 				if k == 0 {
-					dcptl = dcis.Timestamp
+					dcptl = qsPeriod.displayTime(dcis.Timestamp)
 				} else {
 					dcptl = " "
 				}
 				dcln = dc.Nickname
 				dclid = strconv.Itoa(dc.DatacenterId)
-				rowData := []string{dcptl, dclid, dcln, strconv.FormatInt(dc.Requests, 10), dc.Status}
+				// cells are identified by interval relative to the latest
+				key := fmt.Sprintf("interval/%d/%d/", len(objStatus.DatacenterIntervalStatus)-ik, dc.DatacenterId)
+				rowData := []string{dcptl, dclid, dcln, qsHighlighter.cell(key+"requests", strconv.FormatInt(dc.Requests, 10)), qsHighlighter.cell(key+"status", dc.Status)}
 				dcTable.Append(rowData)
 			}
 		}
@@ -714,6 +935,79 @@ func renderPropertyTable(objStatus *PropertyStatus, c *cli.Context) string {
 
 }
 
+// Generate pretty print combined status of multiple properties
+func renderPropertiesTable(propStats []*PropertyStatus, c *cli.Context) string {
+
+	var outString string
+	outString += fmt.Sprintln("Domain: ", domainName)
+	for _, propStat := range propStats {
+		if propStat.Error == "" {
+			outString += fmt.Sprintln("Period Start: ", qsPeriod.displayTime(propStat.PeriodStart))
+			outString += fmt.Sprintln("Period End: ", qsPeriod.displayTime(propStat.PeriodEnd))
+			break
+		}
+	}
+	outString += fmt.Sprintln("Properties: ", len(propStats))
+	outString += fmt.Sprintln(" ")
+	tableString := &strings.Builder{}
+	table := tablewriter.NewWriter(tableString)
+
+	table.SetHeader([]string{"Property", "Datacenter", "Nickname", "Enabled", "Weight", "Total Requests", "Property Usage", "IP", "Alive", "Handed Out", "Score"})
+	table.SetReflowDuringAutoWrap(false)
+	table.SetCenterSeparator(" ")
+	table.SetColumnSeparator(" ")
+	table.SetRowSeparator(" ")
+	table.SetBorder(false)
+	table.SetAutoWrapText(false)
+	table.SetColumnAlignment([]int{tablewriter.ALIGN_LEFT, tablewriter.ALIGN_CENTER, tablewriter.ALIGN_LEFT, tablewriter.ALIGN_CENTER, tablewriter.ALIGN_RIGHT, tablewriter.ALIGN_CENTER, tablewriter.ALIGN_CENTER, tablewriter.ALIGN_CENTER, tablewriter.ALIGN_CENTER, tablewriter.ALIGN_CENTER, tablewriter.ALIGN_CENTER})
+	table.SetAlignment(tablewriter.ALIGN_CENTER)
+
+	var failures []string
+	for _, propStat := range propStats {
+		if propStat.Error != "" {
+			table.Append([]string{propStat.PropertyName, "Status not available", " ", " ", " ", " ", " ", " ", " ", " ", " "})
+			failures = append(failures, fmt.Sprintf("%s: %s", propStat.PropertyName, propStat.Error))
+			continue
+		}
+		if len(propStat.StatusSummary.PropertyDCStatus) == 0 {
+			table.Append([]string{propStat.PropertyName, "No status summary data available", " ", " ", " ", " ", " ", " ", " ", " ", " "})
+			continue
+		}
+		propName := propStat.PropertyName
+		for _, dc := range propStat.StatusSummary.PropertyDCStatus {
+			tkey := fmt.Sprintf("%s/target/%d/", propStat.PropertyName, dc.DatacenterId)
+			dclid := strconv.Itoa(dc.DatacenterId)
+			dcln := dc.Nickname
+			dcenabled := qsHighlighter.cell(tkey+"enabled", strconv.FormatBool(dc.DCEnabled))
+			dcweight := qsHighlighter.cell(tkey+"weight", strconv.FormatFloat(dc.DCWeight, 'f', 1, 64))
+			dcptr := qsHighlighter.cell(tkey+"requests", strconv.FormatInt(dc.DCTotalPeriodRequests, 10))
+			dcpperc := qsHighlighter.cell(tkey+"usage", dc.DCPropertyUsage)
+			ips := dc.IPs
+			if len(ips) < 1 {
+				ips = []*reportsgtm.IpStatIp{&reportsgtm.IpStatIp{}}
+			}
+			for _, ip := range ips {
+				key := fmt.Sprintf("%s%s/", tkey, ip.Ip)
+				rowData := []string{propName, dclid, dcln, dcenabled, dcweight, dcptr, dcpperc, ip.Ip,
+					qsHighlighter.cell(key+"alive", strconv.FormatBool(ip.Alive)), qsHighlighter.cell(key+"handedout", strconv.FormatBool(ip.HandedOut)), qsHighlighter.cell(key+"score", fmt.Sprintf("%.2f", ip.Score))}
+				table.Append(rowData)
+				propName, dclid, dcln, dcenabled, dcweight, dcptr, dcpperc = " ", " ", " ", " ", " ", " ", " "
+			}
+		}
+	}
+	table.Render()
+	outString += fmt.Sprintln(tableString.String())
+
+	if verboseStatus && len(failures) > 0 {
+		outString += fmt.Sprintln("Unable to retrieve status of properties:")
+		for _, f := range failures {
+			outString += fmt.Sprintln("   " + f)
+		}
+	}
+	return outString
+
+}
+
 // Pretty print output
 func renderDomainTable(status *configgtm.ResponseStatus, c *cli.Context) string {
 
@@ -735,15 +1029,15 @@ func renderDomainTable(status *configgtm.ResponseStatus, c *cli.Context) string
 	table.SetAlignment(tablewriter.ALIGN_CENTER)
 
 	// Build status table. Exclude Links.
-	rowData := []string{"ChangeId", status.ChangeId}
+	rowData := []string{"ChangeId", qsHighlighter.cell("ChangeId", status.ChangeId)}
 	table.Append(rowData)
-	rowData = []string{"Message", status.Message}
+	rowData = []string{"Message", qsHighlighter.cell("Message", status.Message)}
 	table.Append(rowData)
-	rowData = []string{"Passing Validation", strconv.FormatBool(status.PassingValidation)}
+	rowData = []string{"Passing Validation", qsHighlighter.cell("Passing Validation", strconv.FormatBool(status.PassingValidation))}
 	table.Append(rowData)
-	rowData = []string{"Propagation Status", status.PropagationStatus}
+	rowData = []string{"Propagation Status", qsHighlighter.cell("Propagation Status", status.PropagationStatus)}
 	table.Append(rowData)
-	rowData = []string{"Propagation Status Date", status.PropagationStatusDate}
+	rowData = []string{"Propagation Status Date", qsHighlighter.cell("Propagation Status Date", status.PropagationStatusDate)}
 	table.Append(rowData)
 
 	table.Render()
@@ -0,0 +1,217 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	akamai "github.com/akamai/cli-common-golang"
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// ServerHit represents a traffic target referencing the server being replaced
+type ServerHit struct {
+	PropName     string
+	DatacenterId int
+	TargetName   string
+	OldServers   []string
+	NewServers   []string
+}
+
+// worker function for replace-server
+func cmdReplaceServer(c *cli.Context) error {
+
+	var rsTimeout int = defaultTimeout
+	var rsDryrun bool = false
+	var rsComplete bool = false
+	var serverHits []*ServerHit
+
+	config, err := akamai.GetEdgegridConfig(c)
+	if err != nil {
+		return err
+	}
+
+	configgtm.Init(config)
+
+	if c.NArg() == 0 {
+		cli.ShowCommandHelp(c, c.Command.Name)
+		return cli.NewExitError(color.RedString("domain name is required"), 1)
+	}
+
+	domainName := c.Args().First()
+	rsDatacenters := c.Generic("datacenter").(*arrayFlags)
+	oldServer := strings.TrimSpace(c.String("old"))
+	newServer := strings.TrimSpace(c.String("new"))
+	if oldServer == "" || newServer == "" {
+		cli.ShowCommandHelp(c, c.Command.Name)
+		return cli.NewExitError(color.RedString("old and new servers are required"), 1)
+	}
+	if net.ParseIP(oldServer) == nil {
+		return cli.NewExitError(color.RedString(fmt.Sprintf("Invalid old server %s. Server must be an IP address", oldServer)), 1)
+	}
+	if net.ParseIP(newServer) == nil {
+		return cli.NewExitError(color.RedString(fmt.Sprintf("Invalid new server %s. Server must be an IP address", newServer)), 1)
+	}
+	if net.ParseIP(oldServer).Equal(net.ParseIP(newServer)) {
+		return cli.NewExitError(color.RedString("old and new servers must be different"), 1)
+	}
+	if c.IsSet("verbose") {
+		verboseStatus = true
+	}
+	if c.IsSet("complete") {
+		rsComplete = true
+	}
+	if c.IsSet("dryrun") {
+		rsDryrun = true
+	}
+	if c.IsSet("timeout") {
+		rsTimeout = c.Int("timeout")
+	}
+
+	// resolve datacenter ids and nicknames
+	err = ResolveDatacenters(c, rsDatacenters, domainName, false)
+	if err != nil {
+		return cli.NewExitError(color.RedString(err.Error()), 1)
+	}
+
+	if !c.IsSet("json") {
+		fmt.Println(fmt.Sprintf("Replacing server %s with %s in domain %s ", oldServer, newServer, domainName))
+	}
+
+	dom, err := configgtm.GetDomain(domainName)
+	if err != nil {
+		return cli.NewExitError(color.RedString("Domain "+domainName+" not found "), 1)
+	}
+	properties := dom.Properties
+	propmsg := fmt.Sprintf("%s contains %s properties", domainName, strconv.Itoa(len(properties)))
+	if !c.IsSet("json") {
+		fmt.Println(propmsg)
+	}
+	plan := newChangePlan(c, domainName, rsDryrun)
+	var dcIDs []int
+	if c.IsSet("datacenter") {
+		dcIDs = rsDatacenters.flagList
+	}
+	for _, propPtr := range properties {
+		enabledBefore := targetEnabledState(propPtr)
+		weightsBefore := targetWeights(propPtr)
+		hits := replaceTargetServer(propPtr, dcIDs, oldServer, newServer)
+		if len(hits) == 0 {
+			continue
+		}
+		if err := validateServerAddresses([]string{newServer}, propPtr.Ipv6); err != nil {
+			propError := &FailUpdate{PropName: propPtr.Name, FailMsg: err.Error()}
+			failedArray = append(failedArray, propError)
+			continue
+		}
+		serverHits = append(serverHits, hits...)
+		plan.addProperty(propPtr, enabledBefore, weightsBefore)
+	}
+
+	if err := enforcePolicy(c, plan); err != nil {
+		return err
+	}
+	// planned replacements are shown before confirmation and in dryrun output
+	if !c.IsSet("json") {
+		fmt.Fprintln(c.App.Writer, renderServerHits(oldServer, serverHits))
+	}
+	if err := confirmPlan(c, plan); err != nil {
+		return err
+	}
+	for _, propPtr := range plan.properties {
+		if !c.IsSet("json") {
+			akamai.StartSpinner(fmt.Sprintf("Updating Property: %s", propPtr.Name), "")
+		}
+		recordPropertyUpdate(c, domainName, propPtr, rsDryrun)
+		if !c.IsSet("json") {
+			akamai.StopSpinnerOk()
+		}
+	}
+
+	if rsComplete && (len(succVerboseArray) > 0 || len(succShortArray) > 0) {
+		waitForDomainCompletion(c, domainName, rsTimeout)
+	}
+
+	return renderUpdateSummary(c, "Server Replacement Summary", len(properties), rsDryrun)
+
+}
+
+// replaceTargetServer replaces oldServer with newServer in the property traffic targets of the specified
+// datacenters, or of all datacenters if none are specified. Returns a hit for each target changed.
+func replaceTargetServer(property *configgtm.Property, dcIDs []int, oldServer, newServer string) []*ServerHit {
+
+	var hits []*ServerHit
+	for _, traffTarg := range property.TrafficTargets {
+		if len(dcIDs) > 0 {
+			dcMatch := false
+			for _, dcID := range dcIDs {
+				if traffTarg.DatacenterId == dcID {
+					dcMatch = true
+				}
+			}
+			if !dcMatch {
+				continue
+			}
+		}
+		if len(missingServers(property, []int{traffTarg.DatacenterId}, []string{oldServer})) > 0 {
+			continue
+		}
+		servers, _ := editServerList(traffTarg.Servers, []string{newServer}, []string{oldServer})
+		hits = append(hits, &ServerHit{PropName: property.Name, DatacenterId: traffTarg.DatacenterId, TargetName: traffTarg.Name, OldServers: traffTarg.Servers, NewServers: servers})
+		traffTarg.Servers = servers
+	}
+	return hits
+}
+
+// Pretty print server references
+func renderServerHits(server string, hits []*ServerHit) string {
+
+	var outString string
+	outString += fmt.Sprintln(" ")
+	outString += fmt.Sprintln(fmt.Sprintf("Traffic targets referencing %s", server))
+	outString += fmt.Sprintln(" ")
+	tableString := &strings.Builder{}
+	table := tablewriter.NewWriter(tableString)
+
+	table.SetHeader([]string{"Property", "Datacenter", "Target Name", "Servers"})
+	table.SetReflowDuringAutoWrap(false)
+	table.SetCenterSeparator(" ")
+	table.SetColumnSeparator(" ")
+	table.SetRowSeparator(" ")
+	table.SetBorder(false)
+	table.SetAutoWrapText(false)
+	table.SetColumnAlignment([]int{tablewriter.ALIGN_LEFT, tablewriter.ALIGN_CENTER, tablewriter.ALIGN_LEFT, tablewriter.ALIGN_LEFT})
+	table.SetAlignment(tablewriter.ALIGN_CENTER)
+
+	if len(hits) == 0 {
+		rowData := []string{"No traffic targets reference server", " ", " ", " "}
+		table.Append(rowData)
+	} else {
+		for _, hit := range hits {
+			servers := fmt.Sprintf("%s -> %s", strings.Join(hit.OldServers, ", "), strings.Join(hit.NewServers, ", "))
+			rowData := []string{hit.PropName, strconv.Itoa(hit.DatacenterId), hit.TargetName, servers}
+			table.Append(rowData)
+		}
+	}
+	table.Render()
+	outString += fmt.Sprintln(tableString.String())
+
+	return outString
+}
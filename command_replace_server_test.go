@@ -0,0 +1,86 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestReplaceTargetServer(t *testing.T) {
+
+	newProperty := func() *configgtm.Property {
+		return &configgtm.Property{Name: "www", TrafficTargets: []*configgtm.TrafficTarget{
+			{DatacenterId: 3131, Name: "east", Servers: []string{"192.0.2.1", "192.0.2.2"}},
+			{DatacenterId: 3132, Name: "west", Servers: []string{"192.0.2.1"}},
+			{DatacenterId: 3133, Name: "central", Servers: []string{"192.0.2.3"}},
+		}}
+	}
+	tests := []struct {
+		name      string
+		dcIDs     []int
+		oldServer string
+		newServer string
+		want      []ServerHit
+	}{
+		{"all datacenters", nil, "192.0.2.1", "192.0.2.9", []ServerHit{
+			{PropName: "www", DatacenterId: 3131, TargetName: "east", OldServers: []string{"192.0.2.1", "192.0.2.2"}, NewServers: []string{"192.0.2.2", "192.0.2.9"}},
+			{PropName: "www", DatacenterId: 3132, TargetName: "west", OldServers: []string{"192.0.2.1"}, NewServers: []string{"192.0.2.9"}},
+		}},
+		{"datacenter filter", []int{3132, 3133}, "192.0.2.1", "192.0.2.9", []ServerHit{
+			{PropName: "www", DatacenterId: 3132, TargetName: "west", OldServers: []string{"192.0.2.1"}, NewServers: []string{"192.0.2.9"}},
+		}},
+		{"new server already present", []int{3131}, "192.0.2.1", "192.0.2.2", []ServerHit{
+			{PropName: "www", DatacenterId: 3131, TargetName: "east", OldServers: []string{"192.0.2.1", "192.0.2.2"}, NewServers: []string{"192.0.2.2"}},
+		}},
+		{"no reference", nil, "192.0.2.8", "192.0.2.9", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			property := newProperty()
+			hits := replaceTargetServer(property, tt.dcIDs, tt.oldServer, tt.newServer)
+			var got []ServerHit
+			for _, hit := range hits {
+				got = append(got, *hit)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Fatalf("hits %+v, want %+v", got, tt.want)
+			}
+			for _, hit := range hits {
+				for _, traffTarg := range property.TrafficTargets {
+					if traffTarg.DatacenterId == hit.DatacenterId && !reflect.DeepEqual(traffTarg.Servers, hit.NewServers) {
+						t.Errorf("datacenter %d servers %v, want %v", hit.DatacenterId, traffTarg.Servers, hit.NewServers)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestRenderServerHits(t *testing.T) {
+
+	hits := []*ServerHit{{PropName: "www", DatacenterId: 3131, TargetName: "east", OldServers: []string{"192.0.2.1", "192.0.2.2"}, NewServers: []string{"192.0.2.2", "192.0.2.9"}}}
+	out := renderServerHits("192.0.2.1", hits)
+	for _, want := range []string{"Traffic targets referencing 192.0.2.1", "www", "3131", "east", "192.0.2.1, 192.0.2.2 -> 192.0.2.2, 192.0.2.9"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q:\n%s", want, out)
+		}
+	}
+	if out := renderServerHits("192.0.2.1", nil); !strings.Contains(out, "No traffic targets reference server") {
+		t.Errorf("unexpected output for no hits:\n%s", out)
+	}
+}
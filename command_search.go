@@ -0,0 +1,334 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	akamai "github.com/akamai/cli-common-golang"
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli"
+	"net"
+	"strconv"
+	"strings"
+)
+
+// SearchHit represents a reference to the search term within a domain
+type SearchHit struct {
+	Domain     string
+	ObjectType string
+	ObjectName string
+	Target     string `json:",omitempty"`
+	Field      string
+	Value      string
+}
+
+// searchMatcher compares the search term against domain values
+type searchMatcher struct {
+	term  string
+	exact bool
+	ip    net.IP
+}
+
+// newSearchMatcher creates a matcher for term
+func newSearchMatcher(term string, exact bool) *searchMatcher {
+
+	return &searchMatcher{term: term, exact: exact, ip: net.ParseIP(term)}
+}
+
+// match returns true if value matches the search term
+func (m *searchMatcher) match(value string) bool {
+
+	if value == "" {
+		return false
+	}
+	if m.ip != nil {
+		if valIP := net.ParseIP(value); valIP != nil {
+			return valIP.Equal(m.ip)
+		}
+	}
+	if m.exact {
+		return strings.EqualFold(value, m.term)
+	}
+	return strings.Contains(strings.ToLower(value), strings.ToLower(m.term))
+}
+
+// matchID returns true if term is the specified datacenter id
+func (m *searchMatcher) matchID(id int) bool {
+
+	return m.term == strconv.Itoa(id)
+}
+
+// matchBlock returns true if the term is the CIDR block or an IP address within it
+func (m *searchMatcher) matchBlock(block string) bool {
+
+	if m.match(block) {
+		return true
+	}
+	if m.ip != nil {
+		if _, ipNet, err := net.ParseCIDR(block); err == nil {
+			return ipNet.Contains(m.ip)
+		}
+	}
+	return false
+}
+
+// searchDomain walks domain objects collecting references to the search term
+func searchDomain(dom *configgtm.Domain, m *searchMatcher) []*SearchHit {
+
+	var hits []*SearchHit
+	addHit := func(objType, objName, target, field, value string) {
+		hits = append(hits, &SearchHit{Domain: dom.Name, ObjectType: objType, ObjectName: objName, Target: target, Field: field, Value: value})
+	}
+	dcNicknames := make(map[int]string)
+	for _, dc := range dom.Datacenters {
+		dcNicknames[dc.DatacenterId] = dc.Nickname
+		if m.matchID(dc.DatacenterId) {
+			addHit("datacenter", dc.Nickname, "", "datacenterId", strconv.Itoa(dc.DatacenterId))
+		}
+		if m.match(dc.Nickname) {
+			addHit("datacenter", dc.Nickname, "", "nickname", dc.Nickname)
+		}
+	}
+	// datacenter references may be by id or nickname
+	matchDC := func(dcID int) (string, bool) {
+		if m.matchID(dcID) {
+			return strconv.Itoa(dcID), true
+		}
+		if m.match(dcNicknames[dcID]) {
+			return dcNicknames[dcID], true
+		}
+		return "", false
+	}
+	dcTarget := func(dcID int) string {
+		return fmt.Sprintf("datacenter %d", dcID)
+	}
+
+	for _, prop := range dom.Properties {
+		for _, tt := range prop.TrafficTargets {
+			if val, ok := matchDC(tt.DatacenterId); ok {
+				addHit("property", prop.Name, dcTarget(tt.DatacenterId), "datacenterId", val)
+			}
+			for _, server := range tt.Servers {
+				if m.match(server) {
+					addHit("property", prop.Name, dcTarget(tt.DatacenterId), "servers", server)
+				}
+			}
+			if m.match(tt.HandoutCName) {
+				addHit("property", prop.Name, dcTarget(tt.DatacenterId), "handoutCName", tt.HandoutCName)
+			}
+			if m.match(tt.Name) {
+				addHit("property", prop.Name, dcTarget(tt.DatacenterId), "name", tt.Name)
+			}
+		}
+		for _, lt := range prop.LivenessTests {
+			target := fmt.Sprintf("liveness test %s", lt.Name)
+			if m.match(lt.TestObject) {
+				addHit("property", prop.Name, target, "testObject", lt.TestObject)
+			}
+			for _, hdr := range lt.HttpHeaders {
+				if strings.EqualFold(hdr.Name, "Host") && m.match(hdr.Value) {
+					addHit("property", prop.Name, target, "httpHeaders.Host", hdr.Value)
+				}
+			}
+		}
+		for _, rrset := range prop.StaticRRSets {
+			for _, rdata := range rrset.Rdata {
+				if m.match(rdata) {
+					addHit("property", prop.Name, fmt.Sprintf("static RR set %s", rrset.Type), "rdata", rdata)
+				}
+			}
+		}
+		if m.match(prop.BackupIp) {
+			addHit("property", prop.Name, "", "backupIp", prop.BackupIp)
+		}
+		if m.match(prop.BackupCName) {
+			addHit("property", prop.Name, "", "backupCName", prop.BackupCName)
+		}
+	}
+
+	for _, geo := range dom.GeographicMaps {
+		if geo.DefaultDatacenter != nil {
+			if val, ok := matchDC(geo.DefaultDatacenter.DatacenterId); ok {
+				addHit("geographicMap", geo.Name, "default datacenter", "datacenterId", val)
+			}
+		}
+		for _, assign := range geo.Assignments {
+			if val, ok := matchDC(assign.DatacenterId); ok {
+				addHit("geographicMap", geo.Name, dcTarget(assign.DatacenterId), "datacenterId", val)
+			}
+			for _, country := range assign.Countries {
+				if m.match(country) {
+					addHit("geographicMap", geo.Name, dcTarget(assign.DatacenterId), "countries", country)
+				}
+			}
+		}
+	}
+	for _, cidr := range dom.CidrMaps {
+		if cidr.DefaultDatacenter != nil {
+			if val, ok := matchDC(cidr.DefaultDatacenter.DatacenterId); ok {
+				addHit("cidrMap", cidr.Name, "default datacenter", "datacenterId", val)
+			}
+		}
+		for _, assign := range cidr.Assignments {
+			if val, ok := matchDC(assign.DatacenterId); ok {
+				addHit("cidrMap", cidr.Name, dcTarget(assign.DatacenterId), "datacenterId", val)
+			}
+			for _, block := range assign.Blocks {
+				if m.matchBlock(block) {
+					addHit("cidrMap", cidr.Name, dcTarget(assign.DatacenterId), "blocks", block)
+				}
+			}
+		}
+	}
+	for _, as := range dom.AsMaps {
+		if as.DefaultDatacenter != nil {
+			if val, ok := matchDC(as.DefaultDatacenter.DatacenterId); ok {
+				addHit("asMap", as.Name, "default datacenter", "datacenterId", val)
+			}
+		}
+		for _, assign := range as.Assignments {
+			if val, ok := matchDC(assign.DatacenterId); ok {
+				addHit("asMap", as.Name, dcTarget(assign.DatacenterId), "datacenterId", val)
+			}
+			for _, asn := range assign.AsNumbers {
+				if m.match(strconv.FormatInt(asn, 10)) {
+					addHit("asMap", as.Name, dcTarget(assign.DatacenterId), "asNumbers", strconv.FormatInt(asn, 10))
+				}
+			}
+		}
+	}
+
+	return hits
+}
+
+// worker function for search
+func cmdSearch(c *cli.Context) error {
+
+	var domainNames []string
+	var term string
+	var hits []*SearchHit
+
+	config, err := akamai.GetEdgegridConfig(c)
+	if err != nil {
+		return err
+	}
+
+	configgtm.Init(config)
+
+	if c.IsSet("verbose") {
+		verboseStatus = true
+	}
+	if c.IsSet("all-domains") {
+		if c.NArg() != 1 {
+			cli.ShowCommandHelp(c, c.Command.Name)
+			return cli.NewExitError(color.RedString("search term is required"), 1)
+		}
+		term = c.Args().Get(0)
+	} else {
+		if c.NArg() != 2 {
+			cli.ShowCommandHelp(c, c.Command.Name)
+			return cli.NewExitError(color.RedString("domain and search term are required"), 1)
+		}
+		domainNames = append(domainNames, c.Args().Get(0))
+		term = c.Args().Get(1)
+	}
+	if strings.TrimSpace(term) == "" {
+		return cli.NewExitError(color.RedString("search term may not be empty"), 1)
+	}
+
+	if c.IsSet("all-domains") {
+		domainNames, err = cachedDomainNames(c)
+		if err != nil {
+			if verboseStatus {
+				return cli.NewExitError(color.RedString("Unable to retrieve domain list. "+err.Error()), 1)
+			}
+			return cli.NewExitError(color.RedString("Unable to retrieve domain list."), 1)
+		}
+	}
+
+	matcher := newSearchMatcher(term, c.Bool("exact"))
+	for _, domainName := range domainNames {
+		if !c.IsSet("json") {
+			akamai.StartSpinner(fmt.Sprintf("Searching domain: %s ", domainName), "")
+		}
+		dom, err := cachedDomain(c, domainName)
+		if err != nil {
+			if !c.IsSet("json") {
+				akamai.StopSpinnerFail()
+			}
+			if len(domainNames) == 1 {
+				return cli.NewExitError(color.RedString("Domain "+domainName+" not found "), 1)
+			}
+			continue
+		}
+		hits = append(hits, searchDomain(dom, matcher)...)
+		if !c.IsSet("json") {
+			akamai.StopSpinnerOk()
+		}
+	}
+
+	if c.IsSet("json") && c.Bool("json") {
+		if hits == nil {
+			hits = []*SearchHit{}
+		}
+		json, err := json.MarshalIndent(hits, "", "  ")
+		if err != nil {
+			return cli.NewExitError(color.RedString("Unable to display search results"), 1)
+		}
+		fmt.Fprintln(c.App.Writer, string(json))
+		return nil
+	}
+	fmt.Fprintln(c.App.Writer, renderSearchTable(term, hits))
+
+	return nil
+}
+
+// Pretty print search hits
+func renderSearchTable(term string, hits []*SearchHit) string {
+
+	var outString string
+	outString += fmt.Sprintln(" ")
+	outString += fmt.Sprintln(fmt.Sprintf("References to %s", term))
+	outString += fmt.Sprintln(" ")
+	tableString := &strings.Builder{}
+	table := tablewriter.NewWriter(tableString)
+
+	table.SetHeader([]string{"Domain", "Object Type", "Object Name", "Target", "Field", "Value"})
+	table.SetReflowDuringAutoWrap(false)
+	table.SetCenterSeparator(" ")
+	table.SetColumnSeparator(" ")
+	table.SetRowSeparator(" ")
+	table.SetBorder(false)
+	table.SetAutoWrapText(false)
+	table.SetColumnAlignment([]int{tablewriter.ALIGN_LEFT, tablewriter.ALIGN_LEFT, tablewriter.ALIGN_LEFT, tablewriter.ALIGN_LEFT, tablewriter.ALIGN_LEFT, tablewriter.ALIGN_LEFT})
+	table.SetAlignment(tablewriter.ALIGN_CENTER)
+
+	if len(hits) == 0 {
+		rowData := []string{"No references found", " ", " ", " ", " ", " "}
+		table.Append(rowData)
+	} else {
+		for _, hit := range hits {
+			rowData := []string{hit.Domain, hit.ObjectType, hit.ObjectName, hit.Target, hit.Field, hit.Value}
+			table.Append(rowData)
+		}
+	}
+	table.Render()
+	outString += fmt.Sprintln(tableString.String())
+
+	return outString
+}
@@ -0,0 +1,119 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	"reflect"
+	"testing"
+)
+
+func TestSearchMatcherMatch(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		term  string
+		exact bool
+		value string
+		want  bool
+	}{
+		{"substring", "origin", false, "Origin-East.example.com", true},
+		{"substring not exact", "origin", true, "origin-east.example.com", false},
+		{"exact ignores case", "ORIGIN.example.com", true, "origin.example.com", true},
+		{"empty value", "origin", false, "", false},
+		{"equal IPv6 addresses", "2001:db8::1", true, "2001:0db8:0:0:0:0:0:1", true},
+		{"IP is not a substring match", "192.0.2.1", false, "192.0.2.10", false},
+		{"IP term against hostname", "192.0.2.1", false, "host-192.0.2.1.example.com", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := newSearchMatcher(tt.term, tt.exact).match(tt.value); got != tt.want {
+				t.Errorf("match(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchMatcherMatchBlock(t *testing.T) {
+
+	tests := []struct {
+		term  string
+		block string
+		want  bool
+	}{
+		{"192.0.2.77", "192.0.2.0/24", true},
+		{"198.51.100.1", "192.0.2.0/24", false},
+		{"192.0.2.0/24", "192.0.2.0/24", true},
+		{"2001:db8::5", "2001:db8::/32", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.term+" "+tt.block, func(t *testing.T) {
+			if got := newSearchMatcher(tt.term, false).matchBlock(tt.block); got != tt.want {
+				t.Errorf("matchBlock(%q) = %v, want %v", tt.block, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchDomain(t *testing.T) {
+
+	dom := &configgtm.Domain{
+		Name:        "example.akadns.net",
+		Datacenters: []*configgtm.Datacenter{{DatacenterId: 3131, Nickname: "east"}, {DatacenterId: 3132, Nickname: "west"}},
+		Properties: []*configgtm.Property{{
+			Name: "www",
+			TrafficTargets: []*configgtm.TrafficTarget{
+				{DatacenterId: 3131, Servers: []string{"192.0.2.1"}},
+				{DatacenterId: 3132, Servers: []string{"192.0.2.2"}},
+			},
+		}},
+		CidrMaps: []*configgtm.CidrMap{{
+			Name:              "office",
+			DefaultDatacenter: &configgtm.DatacenterBase{DatacenterId: 3132},
+			Assignments:       []*configgtm.CidrAssignment{{DatacenterBase: configgtm.DatacenterBase{DatacenterId: 3131}, Blocks: []string{"192.0.2.0/24"}}},
+		}},
+	}
+	tests := []struct {
+		term string
+		want []SearchHit
+	}{
+		{"192.0.2.1", []SearchHit{
+			{Domain: dom.Name, ObjectType: "property", ObjectName: "www", Target: "datacenter 3131", Field: "servers", Value: "192.0.2.1"},
+			{Domain: dom.Name, ObjectType: "cidrMap", ObjectName: "office", Target: "datacenter 3131", Field: "blocks", Value: "192.0.2.0/24"},
+		}},
+		{"3132", []SearchHit{
+			{Domain: dom.Name, ObjectType: "datacenter", ObjectName: "west", Field: "datacenterId", Value: "3132"},
+			{Domain: dom.Name, ObjectType: "property", ObjectName: "www", Target: "datacenter 3132", Field: "datacenterId", Value: "3132"},
+			{Domain: dom.Name, ObjectType: "cidrMap", ObjectName: "office", Target: "default datacenter", Field: "datacenterId", Value: "3132"},
+		}},
+		{"EAST", []SearchHit{
+			{Domain: dom.Name, ObjectType: "datacenter", ObjectName: "east", Field: "nickname", Value: "east"},
+			{Domain: dom.Name, ObjectType: "property", ObjectName: "www", Target: "datacenter 3131", Field: "datacenterId", Value: "east"},
+			{Domain: dom.Name, ObjectType: "cidrMap", ObjectName: "office", Target: "datacenter 3131", Field: "datacenterId", Value: "east"},
+		}},
+		{"north", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.term, func(t *testing.T) {
+			var got []SearchHit
+			for _, hit := range searchDomain(dom, newSearchMatcher(tt.term, false)) {
+				got = append(got, *hit)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("searchDomain(%s) = %+v, want %+v", tt.term, got, tt.want)
+			}
+		})
+	}
+}
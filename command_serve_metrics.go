@@ -0,0 +1,229 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/reportsgtm-v1"
+	akamai "github.com/akamai/cli-common-golang"
+	"github.com/fatih/color"
+	"github.com/urfave/cli"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strconv"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const defaultMetricsListen = ":9779"
+const defaultMetricsInterval = "60s"
+const minMetricsInterval = 10 * time.Second
+
+// metricsExporter collects domain metrics every interval and serves the latest collection
+type metricsExporter struct {
+	domains  []string
+	mu       sync.RWMutex
+	latest   string
+	failures map[string]int
+}
+
+// collect collects the metrics of all domains. Returns the number of domains collected successfully.
+func (e *metricsExporter) collect() int {
+
+	m := newMetricSet()
+	collected := 0
+	for _, domainName := range e.domains {
+		start := time.Now()
+		err := collectDomainMetrics(m, domainName)
+		if err != nil {
+			e.failures[domainName]++
+			if verboseStatus {
+				fmt.Fprintln(os.Stderr, fmt.Sprintf("%s Unable to collect metrics of domain %s. %s", time.Now().Format(time.RFC3339), domainName, err.Error()))
+			}
+		} else {
+			collected++
+		}
+		m.gauge("gtm_collect_success", "Whether the last collection of the domain succeeded.", boolValue(err == nil), "domain", domainName)
+		m.gauge("gtm_collect_duration_seconds", "Duration of the last collection of the domain.", time.Since(start).Seconds(), "domain", domainName)
+		m.add("gtm_collect_failures_total", "counter", "Failed collections of the domain.", float64(e.failures[domainName]), "domain", domainName)
+	}
+	m.gauge("gtm_last_collect_timestamp_seconds", "Time of the last collection.", float64(time.Now().Unix()))
+
+	e.mu.Lock()
+	e.latest = m.String()
+	e.mu.Unlock()
+	return collected
+}
+
+// serveMetrics serves the latest collection
+func (e *metricsExporter) serveMetrics(w http.ResponseWriter, r *http.Request) {
+
+	e.mu.RLock()
+	latest := e.latest
+	e.mu.RUnlock()
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	fmt.Fprint(w, latest)
+}
+
+// collectDomainMetrics collects propagation status, traffic target configuration, IP status and requests of the domain
+func collectDomainMetrics(m *metricSet, domainName string) error {
+
+	dom, err := configgtm.GetDomain(domainName)
+	if err != nil {
+		return err
+	}
+	status, err := configgtm.GetDomainStatus(domainName)
+	if err != nil {
+		return err
+	}
+	m.gauge("gtm_domain_propagation_complete", "Whether the latest domain change has propagated.", boolValue(status.PropagationStatus == "COMPLETE"), "domain", domainName)
+	m.gauge("gtm_domain_propagation_status", "Domain propagation status.", 1, "domain", domainName, "status", status.PropagationStatus)
+	m.gauge("gtm_domain_passing_validation", "Whether the domain passes validation.", boolValue(status.PassingValidation), "domain", domainName)
+
+	nicknames := make(map[int]string)
+	for _, dc := range dom.Datacenters {
+		nicknames[dc.DatacenterId] = dc.Nickname
+	}
+	for _, prop := range dom.Properties {
+		for _, tgt := range prop.TrafficTargets {
+			labels := []string{"domain", domainName, "property", prop.Name, "datacenter_id", strconv.Itoa(tgt.DatacenterId), "datacenter", nicknames[tgt.DatacenterId]}
+			m.gauge("gtm_target_enabled", "Whether the traffic target is enabled.", boolValue(tgt.Enabled), labels...)
+			m.gauge("gtm_target_weight", "Traffic target weight.", tgt.Weight, labels...)
+		}
+	}
+
+	// latest report interval
+	var optArgs map[string]string
+	if window, err := reportsgtm.GetPropertiesTrafficWindow(); err == nil {
+		optArgs = map[string]string{"start": window.EndTime.Add(-defaultPeriod).UTC().Format(time.RFC3339), "end": window.EndTime.UTC().Format(time.RFC3339)}
+	}
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, reportConcurrency)
+	for _, prop := range dom.Properties {
+		wg.Add(1)
+		go func(propName string) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			err := collectPropertyMetrics(m, domainName, propName, optArgs)
+			m.gauge("gtm_property_collect_success", "Whether the last collection of the property reports succeeded.", boolValue(err == nil), "domain", domainName, "property", propName)
+		}(prop.Name)
+	}
+	wg.Wait()
+	return nil
+}
+
+// collectPropertyMetrics collects the most recent IP status and latest report interval requests of the property.
+// Requests are not collected if trafficArgs is nil.
+func collectPropertyMetrics(m *metricSet, domainName string, propName string, trafficArgs map[string]string) error {
+
+	ipStatus, err := reportsgtm.GetIpStatusPerProperty(domainName, propName, map[string]string{"mostRecent": "true"})
+	if err != nil {
+		return err
+	}
+	if len(ipStatus.DataRows) > 0 {
+		for _, dc := range ipStatus.DataRows[0].Datacenters {
+			for _, ip := range dc.IPs {
+				labels := []string{"domain", domainName, "property", propName, "datacenter_id", strconv.Itoa(dc.DatacenterId), "datacenter", dc.Nickname, "ip", ip.Ip}
+				m.gauge("gtm_ip_alive", "Whether the IP is alive.", boolValue(ip.Alive), labels...)
+				m.gauge("gtm_ip_handed_out", "Whether the IP is handed out.", boolValue(ip.HandedOut), labels...)
+				m.gauge("gtm_ip_score", "IP liveness score.", float64(ip.Score), labels...)
+			}
+		}
+	}
+	if trafficArgs == nil {
+		return nil
+	}
+	traffic, err := reportsgtm.GetTrafficPerProperty(domainName, propName, trafficArgs)
+	if err != nil {
+		return err
+	}
+	if len(traffic.DataRows) > 0 {
+		for _, dc := range traffic.DataRows[len(traffic.DataRows)-1].Datacenters {
+			labels := []string{"domain", domainName, "property", propName, "datacenter_id", strconv.Itoa(dc.DatacenterId), "datacenter", dc.Nickname}
+			m.gauge("gtm_property_requests", "Requests served by the datacenter in the latest report interval.", float64(dc.Requests), labels...)
+		}
+	}
+	return nil
+}
+
+// worker function for serve-metrics
+func cmdServeMetrics(c *cli.Context) error {
+
+	config, err := akamai.GetEdgegridConfig(c)
+	if err != nil {
+		return err
+	}
+	configgtm.Init(config)
+	reportsgtm.Init(config)
+
+	if c.IsSet("verbose") {
+		verboseStatus = true
+	}
+	domains := c.StringSlice("domain")
+	if len(domains) == 0 {
+		cli.ShowCommandHelp(c, c.Command.Name)
+		return cli.NewExitError(color.RedString("domain is required"), 1)
+	}
+	interval, err := time.ParseDuration(c.String("interval"))
+	if err != nil || interval < minMetricsInterval {
+		return cli.NewExitError(color.RedString(fmt.Sprintf("Invalid interval %s. Must be a duration of at least %s, e.g. 5m", c.String("interval"), minMetricsInterval)), 1)
+	}
+
+	listener, err := net.Listen("tcp", c.String("listen"))
+	if err != nil {
+		return cli.NewExitError(color.RedString("Unable to listen on "+c.String("listen")+". "+err.Error()), 1)
+	}
+	exporter := &metricsExporter{domains: domains, failures: make(map[string]int)}
+	if exporter.collect() == 0 {
+		listener.Close()
+		return cli.NewExitError(color.RedString("Unable to collect metrics of any domain. Use verbose to display errors"), 1)
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", exporter.serveMetrics)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprintln(w, `<html><head><title>akamai-gtm metrics</title></head><body><a href="/metrics">Metrics</a></body></html>`)
+	})
+	server := &http.Server{Handler: mux}
+	go server.Serve(listener)
+	fmt.Fprintln(c.App.Writer, fmt.Sprintf("Serving metrics of %d domain(s) on %s/metrics, collecting every %s", len(domains), listener.Addr().String(), interval))
+
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigs)
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			exporter.collect()
+		case <-sigs:
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			server.Shutdown(ctx)
+			return nil
+		}
+	}
+}
@@ -0,0 +1,550 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	akamai "github.com/akamai/cli-common-golang"
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli"
+	"net"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const defaultStaticTTL int = 300
+const maxStaticTTL int = 2147483647
+
+// StaticRRSetDiff represents the planned change to a property's static RR sets
+type StaticRRSetDiff struct {
+	PropName string
+	Removed  []string
+	Added    []string
+}
+
+var hostnameRegexp = regexp.MustCompile(`^([a-zA-Z0-9_]([a-zA-Z0-9_-]{0,61}[a-zA-Z0-9_])?\.)*[a-zA-Z0-9_]([a-zA-Z0-9_-]{0,61}[a-zA-Z0-9_])?\.?$`)
+var txtStringRegexp = regexp.MustCompile(`"((?:[^"\\]|\\.)*)"`)
+
+// staticRRSetValidators validates a single rdata value per supported record type
+var staticRRSetValidators = map[string]func(string) error{
+	"A": func(rdata string) error {
+		ip := net.ParseIP(rdata)
+		if ip == nil || ip.To4() == nil {
+			return fmt.Errorf("%s is not a valid IPv4 address", rdata)
+		}
+		return nil
+	},
+	"AAAA": func(rdata string) error {
+		ip := net.ParseIP(rdata)
+		if ip == nil || ip.To4() != nil {
+			return fmt.Errorf("%s is not a valid IPv6 address", rdata)
+		}
+		return nil
+	},
+	"CNAME": validateHostname,
+	"NS":    validateHostname,
+	"PTR":   validateHostname,
+	"MX": func(rdata string) error {
+		fields := strings.Fields(rdata)
+		if len(fields) != 2 {
+			return fmt.Errorf("%s is not valid MX rdata. Expected '<preference> <exchange>'", rdata)
+		}
+		if _, err := strconv.ParseUint(fields[0], 10, 16); err != nil {
+			return fmt.Errorf("%s is not a valid MX preference", fields[0])
+		}
+		return validateHostname(fields[1])
+	},
+	"SRV": func(rdata string) error {
+		fields := strings.Fields(rdata)
+		if len(fields) != 4 {
+			return fmt.Errorf("%s is not valid SRV rdata. Expected '<priority> <weight> <port> <target>'", rdata)
+		}
+		for _, f := range fields[:3] {
+			if _, err := strconv.ParseUint(f, 10, 16); err != nil {
+				return fmt.Errorf("%s is not a valid SRV priority, weight or port", f)
+			}
+		}
+		return validateHostname(fields[3])
+	},
+	"TXT": validateTXT,
+	"SPF": validateTXT,
+	"CAA": func(rdata string) error {
+		fields := strings.SplitN(rdata, " ", 3)
+		if len(fields) != 3 {
+			return fmt.Errorf("%s is not valid CAA rdata. Expected '<flags> <tag> <value>'", rdata)
+		}
+		if _, err := strconv.ParseUint(fields[0], 10, 8); err != nil {
+			return fmt.Errorf("%s is not a valid CAA flag", fields[0])
+		}
+		if fields[1] != "issue" && fields[1] != "issuewild" && fields[1] != "iodef" {
+			return fmt.Errorf("%s is not a valid CAA tag. Acceptable values: issue, issuewild, iodef", fields[1])
+		}
+		return nil
+	},
+}
+
+// singleValueRRTypes may only contain one rdata value
+var singleValueRRTypes = map[string]bool{"CNAME": true}
+
+// validate a hostname rdata value
+func validateHostname(rdata string) error {
+
+	if len(rdata) > 255 || !hostnameRegexp.MatchString(rdata) {
+		return fmt.Errorf("%s is not a valid hostname", rdata)
+	}
+	return nil
+}
+
+// validate TXT rdata. Each character string may be at most 255 characters.
+func validateTXT(rdata string) error {
+
+	if len(rdata) == 0 {
+		return fmt.Errorf("TXT rdata may not be empty")
+	}
+	for _, str := range splitTXTStrings(rdata) {
+		if len(str) > 255 {
+			return fmt.Errorf("TXT character string exceeds 255 characters: %s", str)
+		}
+	}
+	return nil
+}
+
+// split TXT rdata into its quoted character strings. Unquoted rdata is treated as a single string.
+func splitTXTStrings(rdata string) []string {
+
+	if !strings.HasPrefix(rdata, "\"") {
+		return []string{rdata}
+	}
+	var strs []string
+	for _, m := range txtStringRegexp.FindAllStringSubmatch(rdata, -1) {
+		strs = append(strs, m[1])
+	}
+	return strs
+}
+
+// supportedStaticRRTypes returns the sorted list of supported record types
+func supportedStaticRRTypes() []string {
+
+	var types []string
+	for t := range staticRRSetValidators {
+		types = append(types, t)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// validateStaticRRSet checks record type, ttl and rdata
+func validateStaticRRSet(rrset *configgtm.StaticRRSet) error {
+
+	validator, ok := staticRRSetValidators[rrset.Type]
+	if !ok {
+		return fmt.Errorf("Unsupported record type %s. Supported types: %s", rrset.Type, strings.Join(supportedStaticRRTypes(), ", "))
+	}
+	if rrset.TTL < 1 || rrset.TTL > maxStaticTTL {
+		return fmt.Errorf("Invalid ttl %d. ttl must be between 1 and %d", rrset.TTL, maxStaticTTL)
+	}
+	if len(rrset.Rdata) == 0 {
+		return fmt.Errorf("One or more rdata values are required")
+	}
+	if singleValueRRTypes[rrset.Type] && len(rrset.Rdata) > 1 {
+		return fmt.Errorf("%s record sets may only contain one rdata value", rrset.Type)
+	}
+	seen := make(map[string]bool)
+	for _, rdata := range rrset.Rdata {
+		if err := validator(rdata); err != nil {
+			return err
+		}
+		if seen[rdata] {
+			return fmt.Errorf("Duplicate rdata value %s", rdata)
+		}
+		seen[rdata] = true
+	}
+	return nil
+}
+
+// findStaticRRSet returns index of record set of type in property or -1
+func findStaticRRSet(property *configgtm.Property, rrType string) int {
+
+	for i, rrset := range property.StaticRRSets {
+		if strings.EqualFold(rrset.Type, rrType) {
+			return i
+		}
+	}
+	return -1
+}
+
+// flatten record sets to comparable lines
+func staticRRSetLines(rrsets []*configgtm.StaticRRSet) []string {
+
+	var lines []string
+	for _, rrset := range rrsets {
+		for _, rdata := range rrset.Rdata {
+			lines = append(lines, fmt.Sprintf("%s %d %s", rrset.Type, rrset.TTL, rdata))
+		}
+	}
+	return lines
+}
+
+// diffStaticRRSets builds the list of removed and added record lines
+func diffStaticRRSets(propName string, before, after []*configgtm.StaticRRSet) *StaticRRSetDiff {
+
+	diff := &StaticRRSetDiff{PropName: propName, Removed: []string{}, Added: []string{}}
+	beforeLines := staticRRSetLines(before)
+	afterLines := staticRRSetLines(after)
+	inList := func(list []string, val string) bool {
+		for _, v := range list {
+			if v == val {
+				return true
+			}
+		}
+		return false
+	}
+	for _, l := range beforeLines {
+		if !inList(afterLines, l) {
+			diff.Removed = append(diff.Removed, l)
+		}
+	}
+	for _, l := range afterLines {
+		if !inList(beforeLines, l) {
+			diff.Added = append(diff.Added, l)
+		}
+	}
+	return diff
+}
+
+// copy record sets so original state is retained for diff
+func copyStaticRRSets(rrsets []*configgtm.StaticRRSet) []*configgtm.StaticRRSet {
+
+	var copied []*configgtm.StaticRRSet
+	for _, rrset := range rrsets {
+		rrCopy := *rrset
+		rrCopy.Rdata = append([]string{}, rrset.Rdata...)
+		copied = append(copied, &rrCopy)
+	}
+	return copied
+}
+
+// rdataInList reports whether value is in list
+func rdataInList(value string, list []string) bool {
+
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// addStaticRRSetValues appends values not already in the record set and returns those added
+func addStaticRRSetValues(rrset *configgtm.StaticRRSet, values []string) []string {
+
+	var added []string
+	for _, value := range values {
+		if rdataInList(value, rrset.Rdata) {
+			continue
+		}
+		rrset.Rdata = append(rrset.Rdata, value)
+		added = append(added, value)
+	}
+	return added
+}
+
+// removeStaticRRSetValues deletes values from the record set and returns those not found
+func removeStaticRRSetValues(rrset *configgtm.StaticRRSet, values []string) []string {
+
+	var notFound []string
+	for _, value := range values {
+		if !rdataInList(value, rrset.Rdata) {
+			notFound = append(notFound, value)
+		}
+	}
+	var remaining []string
+	for _, rdata := range rrset.Rdata {
+		if !rdataInList(rdata, values) {
+			remaining = append(remaining, rdata)
+		}
+	}
+	rrset.Rdata = remaining
+	return notFound
+}
+
+// parse common domain and property args and retrieve property
+func getStaticRRSetProperty(c *cli.Context) (string, *configgtm.Property, error) {
+
+	config, err := akamai.GetEdgegridConfig(c)
+	if err != nil {
+		return "", nil, err
+	}
+
+	configgtm.Init(config)
+
+	if c.NArg() < 2 {
+		cli.ShowCommandHelp(c, c.Command.Name)
+		return "", nil, cli.NewExitError(color.RedString("domain and property are required"), 1)
+	}
+	domainName := c.Args().Get(0)
+	propertyName := c.Args().Get(1)
+	if c.IsSet("verbose") {
+		verboseStatus = true
+	}
+
+	property, err := configgtm.GetProperty(propertyName, domainName)
+	if err != nil {
+		return "", nil, cli.NewExitError(color.RedString("Property not found"), 1)
+	}
+	return domainName, property, nil
+}
+
+// worker function for static-rrset list
+func cmdStaticRRSetList(c *cli.Context) error {
+
+	_, property, err := getStaticRRSetProperty(c)
+	if err != nil {
+		return err
+	}
+
+	if c.IsSet("json") && c.Bool("json") {
+		rrsets := property.StaticRRSets
+		if rrsets == nil {
+			rrsets = []*configgtm.StaticRRSet{}
+		}
+		json, err := json.MarshalIndent(rrsets, "", "  ")
+		if err != nil {
+			return cli.NewExitError(color.RedString("Unable to display static RR sets"), 1)
+		}
+		fmt.Fprintln(c.App.Writer, string(json))
+		return nil
+	}
+	fmt.Fprintln(c.App.Writer, renderStaticRRSetTable(property))
+
+	return nil
+}
+
+// worker function for static-rrset add, update and remove
+func cmdStaticRRSetModify(c *cli.Context) error {
+
+	var rsTimeout int = defaultTimeout
+
+	domainName, property, err := getStaticRRSetProperty(c)
+	if err != nil {
+		return err
+	}
+	if c.IsSet("timeout") {
+		rsTimeout = c.Int("timeout")
+	}
+
+	action := c.Command.Name
+	rrType := strings.ToUpper(c.String("type"))
+	if rrType == "" {
+		cli.ShowCommandHelp(c, c.Command.Name)
+		return cli.NewExitError(color.RedString("record type is required"), 1)
+	}
+	before := copyStaticRRSets(property.StaticRRSets)
+	indx := findStaticRRSet(property, rrType)
+
+	switch action {
+	case "add":
+		if indx >= 0 {
+			// append to existing record set
+			rrset := property.StaticRRSets[indx]
+			addStaticRRSetValues(rrset, c.StringSlice("rdata"))
+			if c.IsSet("ttl") {
+				rrset.TTL = c.Int("ttl")
+			}
+			if err := validateStaticRRSet(rrset); err != nil {
+				return cli.NewExitError(color.RedString(err.Error()), 1)
+			}
+			break
+		}
+		rrset := property.NewStaticRRSet()
+		rrset.Type = rrType
+		rrset.Rdata = c.StringSlice("rdata")
+		rrset.TTL = defaultStaticTTL
+		if property.StaticTTL > 0 {
+			rrset.TTL = property.StaticTTL
+		}
+		if c.IsSet("ttl") {
+			rrset.TTL = c.Int("ttl")
+		}
+		if err := validateStaticRRSet(rrset); err != nil {
+			return cli.NewExitError(color.RedString(err.Error()), 1)
+		}
+		property.StaticRRSets = append(property.StaticRRSets, rrset)
+	case "update":
+		if indx < 0 {
+			return cli.NewExitError(color.RedString(fmt.Sprintf("%s record set not found in property %s", rrType, property.Name)), 1)
+		}
+		if !c.IsSet("rdata") && !c.IsSet("ttl") {
+			return cli.NewExitError(color.RedString("rdata and/or ttl must be specified"), 1)
+		}
+		rrset := property.StaticRRSets[indx]
+		if c.IsSet("rdata") {
+			rrset.Rdata = c.StringSlice("rdata")
+		}
+		if c.IsSet("ttl") {
+			rrset.TTL = c.Int("ttl")
+		}
+		if err := validateStaticRRSet(rrset); err != nil {
+			return cli.NewExitError(color.RedString(err.Error()), 1)
+		}
+	case "remove":
+		if indx < 0 {
+			return cli.NewExitError(color.RedString(fmt.Sprintf("%s record set not found in property %s", rrType, property.Name)), 1)
+		}
+		if c.IsSet("rdata") {
+			rrset := property.StaticRRSets[indx]
+			if notFound := removeStaticRRSetValues(rrset, c.StringSlice("rdata")); len(notFound) > 0 {
+				return cli.NewExitError(color.RedString(fmt.Sprintf("rdata value(s) not found in %s record set of property %s: %s", rrType, property.Name, strings.Join(notFound, ", "))), 1)
+			}
+			if len(rrset.Rdata) > 0 {
+				break
+			}
+		}
+		property.StaticRRSets = append(property.StaticRRSets[:indx], property.StaticRRSets[indx+1:]...)
+	}
+
+	diff := diffStaticRRSets(property.Name, before, property.StaticRRSets)
+	if len(diff.Added) == 0 && len(diff.Removed) == 0 {
+		if !c.IsSet("json") {
+			fmt.Fprintln(c.App.Writer, fmt.Sprintf("No update required for Property %s", property.Name))
+		}
+		return nil
+	}
+
+	plan := newChangePlan(c, domainName, c.IsSet("dryrun"))
+	plan.addProperty(property, targetEnabledState(property), targetWeights(property))
+	if err := enforcePolicy(c, plan); err != nil {
+		return err
+	}
+	if err := confirmPlan(c, plan); err != nil {
+		return err
+	}
+
+	if c.IsSet("dryrun") {
+		if c.IsSet("json") && c.Bool("json") {
+			json, err := json.MarshalIndent(diff, "", "  ")
+			if err != nil {
+				return cli.NewExitError(color.RedString("Unable to display proposed static RR set update"), 1)
+			}
+			fmt.Fprintln(c.App.Writer, string(json))
+		} else {
+			fmt.Fprintln(c.App.Writer, "Proposed Static RR Set Update")
+			fmt.Fprintln(c.App.Writer, renderStaticRRSetDiff(diff))
+		}
+		return nil
+	}
+
+	if !c.IsSet("json") {
+		akamai.StartSpinner(fmt.Sprintf("Updating Property: %s", property.Name), "")
+	}
+	propStat, err := property.Update(domainName)
+	if err != nil {
+		if !c.IsSet("json") {
+			akamai.StopSpinnerFail()
+		}
+		return cli.NewExitError(color.RedString(fmt.Sprintf("Error updating property %s. %s", property.Name, err.Error())), 1)
+	}
+	invalidateDomainCache(c, domainName)
+	if !c.IsSet("json") {
+		akamai.StopSpinnerOk()
+	}
+	if c.IsSet("complete") && propStat.PropagationStatus == "PENDING" {
+		waitForDomainCompletion(c, domainName, rsTimeout)
+	}
+
+	var status interface{}
+	if c.IsSet("verbose") && verboseStatus {
+		status = propStat
+	} else {
+		status = fmt.Sprintf("ChangeId: %s", propStat.ChangeId)
+	}
+	if c.IsSet("json") && c.Bool("json") {
+		json, err := json.MarshalIndent(status, "", "  ")
+		if err != nil {
+			return cli.NewExitError(color.RedString("Unable to display status results"), 1)
+		}
+		fmt.Fprintln(c.App.Writer, string(json))
+	} else {
+		fmt.Fprintln(c.App.Writer, "")
+		if c.IsSet("verbose") && verboseStatus {
+			fmt.Fprintln(c.App.Writer, renderStatus(status.(*configgtm.ResponseStatus), c))
+		} else {
+			fmt.Fprintln(c.App.Writer, "Response Status")
+			fmt.Fprintln(c.App.Writer, " ")
+			fmt.Fprintln(c.App.Writer, status)
+		}
+	}
+
+	return nil
+}
+
+// Pretty print property static RR sets
+func renderStaticRRSetTable(property *configgtm.Property) string {
+
+	var outString string
+	outString += fmt.Sprintln("Property: ", property.Name)
+	outString += fmt.Sprintln(" ")
+	tableString := &strings.Builder{}
+	table := tablewriter.NewWriter(tableString)
+
+	table.SetHeader([]string{"Type", "TTL", "Rdata"})
+	table.SetReflowDuringAutoWrap(false)
+	table.SetCenterSeparator(" ")
+	table.SetColumnSeparator(" ")
+	table.SetRowSeparator(" ")
+	table.SetBorder(false)
+	table.SetAutoWrapText(false)
+	table.SetColumnAlignment([]int{tablewriter.ALIGN_LEFT, tablewriter.ALIGN_RIGHT, tablewriter.ALIGN_LEFT})
+	table.SetAlignment(tablewriter.ALIGN_CENTER)
+
+	if len(property.StaticRRSets) == 0 {
+		rowData := []string{"No static RR sets", " ", " "}
+		table.Append(rowData)
+	} else {
+		for _, rrset := range property.StaticRRSets {
+			for k, rdata := range rrset.Rdata {
+				rowData := []string{" ", " ", rdata}
+				if k == 0 {
+					rowData = []string{rrset.Type, strconv.Itoa(rrset.TTL), rdata}
+				}
+				table.Append(rowData)
+			}
+		}
+	}
+	table.Render()
+	outString += fmt.Sprintln(tableString.String())
+
+	return outString
+}
+
+// Pretty print static RR set diff
+func renderStaticRRSetDiff(diff *StaticRRSetDiff) string {
+
+	var outString string
+	outString += fmt.Sprintln("Property: ", diff.PropName)
+	outString += fmt.Sprintln(" ")
+	for _, l := range diff.Removed {
+		outString += fmt.Sprintln(color.RedString("- " + l))
+	}
+	for _, l := range diff.Added {
+		outString += fmt.Sprintln(color.GreenString("+ " + l))
+	}
+	return outString
+}
@@ -0,0 +1,124 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestValidateStaticRRSet(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		rrType  string
+		ttl     int
+		rdata   []string
+		errText string
+	}{
+		{"A", "A", 300, []string{"192.0.2.1", "192.0.2.2"}, ""},
+		{"A with IPv6", "A", 300, []string{"2001:db8::1"}, "not a valid IPv4 address"},
+		{"AAAA", "AAAA", 300, []string{"2001:db8::1"}, ""},
+		{"AAAA with IPv4", "AAAA", 300, []string{"192.0.2.1"}, "not a valid IPv6 address"},
+		{"CNAME", "CNAME", 300, []string{"origin.example.com."}, ""},
+		{"CNAME multiple values", "CNAME", 300, []string{"a.example.com", "b.example.com"}, "may only contain one rdata value"},
+		{"NS bad hostname", "NS", 300, []string{"ns1..example.com"}, "not a valid hostname"},
+		{"MX", "MX", 300, []string{"10 mail.example.com"}, ""},
+		{"MX missing exchange", "MX", 300, []string{"10"}, "Expected '<preference> <exchange>'"},
+		{"MX bad preference", "MX", 300, []string{"high mail.example.com"}, "not a valid MX preference"},
+		{"SRV", "SRV", 300, []string{"10 5 443 www.example.com"}, ""},
+		{"SRV bad port", "SRV", 300, []string{"10 5 70000 www.example.com"}, "not a valid SRV priority, weight or port"},
+		{"TXT", "TXT", 300, []string{`"v=spf1 -all"`}, ""},
+		{"TXT string too long", "TXT", 300, []string{`"` + strings.Repeat("a", 256) + `"`}, "255"},
+		{"CAA", "CAA", 300, []string{`0 issue "letsencrypt.org"`}, ""},
+		{"CAA bad tag", "CAA", 300, []string{`0 policy "letsencrypt.org"`}, "not a valid CAA tag"},
+		{"unsupported type", "SOA", 300, []string{"ns1.example.com"}, "Unsupported record type SOA"},
+		{"ttl zero", "A", 0, []string{"192.0.2.1"}, "Invalid ttl 0"},
+		{"ttl maximum", "A", maxStaticTTL, []string{"192.0.2.1"}, ""},
+		{"no rdata", "A", 300, nil, "One or more rdata values are required"},
+		{"duplicate rdata", "A", 300, []string{"192.0.2.1", "192.0.2.1"}, "Duplicate rdata value 192.0.2.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateStaticRRSet(&configgtm.StaticRRSet{Type: tt.rrType, TTL: tt.ttl, Rdata: tt.rdata})
+			if tt.errText == "" {
+				if err != nil {
+					t.Fatalf("unexpected error %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.errText) {
+				t.Fatalf("error = %v, want %q", err, tt.errText)
+			}
+		})
+	}
+}
+
+func TestAddStaticRRSetValues(t *testing.T) {
+
+	tests := []struct {
+		name      string
+		rdata     []string
+		values    []string
+		wantRdata []string
+		wantAdded []string
+	}{
+		{"append", []string{"192.0.2.1"}, []string{"192.0.2.2"}, []string{"192.0.2.1", "192.0.2.2"}, []string{"192.0.2.2"}},
+		{"skip existing", []string{"192.0.2.1"}, []string{"192.0.2.1", "192.0.2.3"}, []string{"192.0.2.1", "192.0.2.3"}, []string{"192.0.2.3"}},
+		{"all existing", []string{"192.0.2.1"}, []string{"192.0.2.1"}, []string{"192.0.2.1"}, nil},
+		{"skip repeated value", nil, []string{"192.0.2.4", "192.0.2.4"}, []string{"192.0.2.4"}, []string{"192.0.2.4"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rrset := &configgtm.StaticRRSet{Type: "A", TTL: 300, Rdata: tt.rdata}
+			added := addStaticRRSetValues(rrset, tt.values)
+			if !reflect.DeepEqual(rrset.Rdata, tt.wantRdata) {
+				t.Errorf("rdata %v, want %v", rrset.Rdata, tt.wantRdata)
+			}
+			if !reflect.DeepEqual(added, tt.wantAdded) {
+				t.Errorf("added %v, want %v", added, tt.wantAdded)
+			}
+		})
+	}
+}
+
+func TestRemoveStaticRRSetValues(t *testing.T) {
+
+	tests := []struct {
+		name         string
+		rdata        []string
+		values       []string
+		wantRdata    []string
+		wantNotFound []string
+	}{
+		{"remove one", []string{"192.0.2.1", "192.0.2.2"}, []string{"192.0.2.1"}, []string{"192.0.2.2"}, nil},
+		{"remove all", []string{"192.0.2.1", "192.0.2.2"}, []string{"192.0.2.2", "192.0.2.1"}, nil, nil},
+		{"not found", []string{"192.0.2.1"}, []string{"192.0.2.1", "192.0.2.9"}, nil, []string{"192.0.2.9"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rrset := &configgtm.StaticRRSet{Type: "A", TTL: 300, Rdata: tt.rdata}
+			notFound := removeStaticRRSetValues(rrset, tt.values)
+			if !reflect.DeepEqual(rrset.Rdata, tt.wantRdata) {
+				t.Errorf("rdata %v, want %v", rrset.Rdata, tt.wantRdata)
+			}
+			if !reflect.DeepEqual(notFound, tt.wantNotFound) {
+				t.Errorf("not found %v, want %v", notFound, tt.wantNotFound)
+			}
+		})
+	}
+}
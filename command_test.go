@@ -0,0 +1,56 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"github.com/urfave/cli"
+	"strings"
+	"testing"
+)
+
+// newTestContext returns a context of the command, e.g. "static-rrset add", with args parsed by the command flags
+func newTestContext(t *testing.T, name string, args ...string) *cli.Context {
+
+	t.Helper()
+	commands, err := commandLocator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cmd *cli.Command
+	for _, part := range strings.Fields(name) {
+		var found *cli.Command
+		for i := range commands {
+			if commands[i].Name == part {
+				found = &commands[i]
+			}
+		}
+		if found == nil {
+			t.Fatalf("command %s not found", name)
+		}
+		cmd = found
+		commands = cmd.Subcommands
+	}
+	set := flag.NewFlagSet(name, flag.ContinueOnError)
+	for _, f := range cmd.Flags {
+		f.Apply(set)
+	}
+	if err := set.Parse(args); err != nil {
+		t.Fatalf("parse %v: %s", args, err)
+	}
+	ctx := cli.NewContext(cli.NewApp(), set, nil)
+	ctx.Command = *cmd
+	return ctx
+}
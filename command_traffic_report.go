@@ -0,0 +1,513 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/reportsgtm-v1"
+	akamai "github.com/akamai/cli-common-golang"
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const defaultTrafficReportPeriod = 24 * time.Hour
+
+// TrafficReport is the traffic-report result structure
+type TrafficReport struct {
+	Domain             string
+	Property           string `json:",omitempty"`
+	PeriodStart        string
+	PeriodEnd          string
+	ComparePeriodStart string `json:",omitempty"`
+	ComparePeriodEnd   string `json:",omitempty"`
+	Aggregation        string
+	Total              *TrafficCount
+	Datacenters        []*TrafficCount
+	Buckets            []*TrafficBucket
+}
+
+// TrafficBucket represents the requests of an hour or day
+type TrafficBucket struct {
+	Start       string
+	Total       *TrafficCount
+	Datacenters []*TrafficCount
+}
+
+// TrafficCount represents requests and share of the enclosing total. Prior requests and change are included when comparing.
+type TrafficCount struct {
+	DatacenterId  int    `json:",omitempty"`
+	Nickname      string `json:",omitempty"`
+	Requests      int64
+	Share         float64
+	PriorRequests *int64   `json:",omitempty"`
+	Change        *float64 `json:",omitempty"`
+}
+
+// trafficSample is the number of requests served by a datacenter in a report interval
+type trafficSample struct {
+	timestamp    time.Time
+	datacenterId int
+	nickname     string
+	requests     int64
+}
+
+// fetchPropertyTraffic retrieves the property traffic samples between start and end
+func fetchPropertyTraffic(domainName string, propName string, start time.Time, end time.Time) ([]*trafficSample, error) {
+
+	optArgs := map[string]string{"start": start.UTC().Format(time.RFC3339), "end": end.UTC().Format(time.RFC3339)}
+	traffic, err := reportsgtm.GetTrafficPerProperty(domainName, propName, optArgs)
+	if err != nil {
+		return nil, err
+	}
+	var samples []*trafficSample
+	for _, row := range traffic.DataRows {
+		ts, err := time.Parse(time.RFC3339, row.Timestamp)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid report timestamp %s", row.Timestamp)
+		}
+		for _, dc := range row.Datacenters {
+			samples = append(samples, &trafficSample{timestamp: ts, datacenterId: dc.DatacenterId, nickname: dc.Nickname, requests: dc.Requests})
+		}
+	}
+	return samples, nil
+}
+
+// fetchDatacenterTraffic retrieves the traffic samples of the datacenters between start and end. Requests are summed across properties.
+func fetchDatacenterTraffic(domainName string, dcIds []int, start time.Time, end time.Time) ([]*trafficSample, error) {
+
+	optArgs := map[string]string{"start": start.UTC().Format(time.RFC3339), "end": end.UTC().Format(time.RFC3339)}
+	var samples []*trafficSample
+	for _, dcId := range dcIds {
+		traffic, err := reportsgtm.GetTrafficPerDatacenter(domainName, dcId, optArgs)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range traffic.DataRows {
+			ts, err := time.Parse(time.RFC3339, row.Timestamp)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid report timestamp %s", row.Timestamp)
+			}
+			sample := &trafficSample{timestamp: ts, datacenterId: dcId, nickname: traffic.Metadata.DatacenterNickname}
+			for _, prop := range row.Properties {
+				sample.requests += prop.Requests
+			}
+			samples = append(samples, sample)
+		}
+	}
+	return samples, nil
+}
+
+// bucketStart returns the start of the hour or day containing t in loc
+func bucketStart(t time.Time, aggregation string, loc *time.Location) time.Time {
+
+	t = t.In(loc)
+	if aggregation == "day" {
+		return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc)
+	}
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc)
+}
+
+// nextBucket returns the start of the bucket following start
+func nextBucket(start time.Time, aggregation string) time.Time {
+
+	if aggregation == "day" {
+		return start.AddDate(0, 0, 1)
+	}
+	return start.Add(time.Hour)
+}
+
+// percentOf returns part as a percentage of total
+func percentOf(part int64, total int64) float64 {
+
+	if total == 0 {
+		return 0
+	}
+	return float64(part) * 100 / float64(total)
+}
+
+// compareCount sets the prior requests and change of count
+func compareCount(count *TrafficCount, prior int64) {
+
+	count.PriorRequests = &prior
+	if prior != 0 {
+		change := float64(count.Requests-prior) * 100 / float64(prior)
+		count.Change = &change
+	}
+}
+
+// buildTrafficReport aggregates samples into hour or day buckets between start and end.
+// Prior samples, shifted by offset, are compared if not nil.
+func buildTrafficReport(report *TrafficReport, samples []*trafficSample, prior []*trafficSample, offset time.Duration, start time.Time, end time.Time, loc *time.Location) {
+
+	type bucketKey struct {
+		start        int64
+		datacenterId int
+	}
+	requests := make(map[bucketKey]int64)
+	priorRequests := make(map[bucketKey]int64)
+	dcRequests := make(map[int]int64)
+	dcPriorRequests := make(map[int]int64)
+	nicknames := make(map[int]string)
+	var total, priorTotal int64
+
+	for _, s := range samples {
+		key := bucketKey{bucketStart(s.timestamp, report.Aggregation, loc).Unix(), s.datacenterId}
+		requests[key] += s.requests
+		dcRequests[s.datacenterId] += s.requests
+		total += s.requests
+		if s.nickname != "" {
+			nicknames[s.datacenterId] = s.nickname
+		}
+	}
+	for _, s := range prior {
+		key := bucketKey{bucketStart(s.timestamp.Add(offset), report.Aggregation, loc).Unix(), s.datacenterId}
+		priorRequests[key] += s.requests
+		dcPriorRequests[s.datacenterId] += s.requests
+		priorTotal += s.requests
+		if _, ok := nicknames[s.datacenterId]; !ok && s.nickname != "" {
+			nicknames[s.datacenterId] = s.nickname
+		}
+	}
+
+	var dcIds []int
+	seen := make(map[int]bool)
+	for _, s := range append(samples, prior...) {
+		if !seen[s.datacenterId] {
+			seen[s.datacenterId] = true
+			dcIds = append(dcIds, s.datacenterId)
+		}
+	}
+	sort.Ints(dcIds)
+
+	report.Total = &TrafficCount{Requests: total, Share: 100}
+	if prior != nil {
+		compareCount(report.Total, priorTotal)
+	}
+	for _, dcId := range dcIds {
+		count := &TrafficCount{DatacenterId: dcId, Nickname: nicknames[dcId], Requests: dcRequests[dcId], Share: percentOf(dcRequests[dcId], total)}
+		if prior != nil {
+			compareCount(count, dcPriorRequests[dcId])
+		}
+		report.Datacenters = append(report.Datacenters, count)
+	}
+
+	for b := bucketStart(start, report.Aggregation, loc); b.Before(end); b = nextBucket(b, report.Aggregation) {
+		bucket := &TrafficBucket{Start: b.Format(time.RFC3339), Total: &TrafficCount{}}
+		var bucketPrior int64
+		for _, dcId := range dcIds {
+			key := bucketKey{b.Unix(), dcId}
+			bucket.Total.Requests += requests[key]
+			bucketPrior += priorRequests[key]
+			bucket.Datacenters = append(bucket.Datacenters, &TrafficCount{DatacenterId: dcId, Nickname: nicknames[dcId], Requests: requests[key]})
+		}
+		bucket.Total.Share = percentOf(bucket.Total.Requests, total)
+		if prior != nil {
+			compareCount(bucket.Total, bucketPrior)
+		}
+		for _, count := range bucket.Datacenters {
+			count.Share = percentOf(count.Requests, bucket.Total.Requests)
+			if prior != nil {
+				compareCount(count, priorRequests[bucketKey{b.Unix(), count.DatacenterId}])
+			}
+		}
+		report.Buckets = append(report.Buckets, bucket)
+	}
+}
+
+// worker function for traffic-report
+func cmdTrafficReport(c *cli.Context) error {
+
+	config, err := akamai.GetEdgegridConfig(c)
+	if err != nil {
+		return err
+	}
+	configgtm.Init(config)
+	reportsgtm.Init(config)
+
+	if c.NArg() == 0 {
+		cli.ShowCommandHelp(c, c.Command.Name)
+		return cli.NewExitError(color.RedString("domain is required"), 1)
+	}
+	if c.IsSet("verbose") {
+		verboseStatus = true
+	}
+	domainName := c.Args().First()
+	dcs := (c.Generic("datacenter")).(*arrayFlags)
+	if c.IsSet("property") == c.IsSet("datacenter") {
+		return cli.NewExitError(color.RedString("property OR datacenter(s) must be specified"), 1)
+	}
+	if c.Bool("json") && c.Bool("csv") {
+		return cli.NewExitError(color.RedString("json and csv may not both be specified"), 1)
+	}
+	if c.IsSet("chart") && (c.Bool("json") || c.Bool("csv")) {
+		return cli.NewExitError(color.RedString("chart may not be combined with json or csv"), 1)
+	}
+	aggregation := strings.ToLower(c.String("aggregate"))
+	if aggregation != "hour" && aggregation != "day" {
+		return cli.NewExitError(color.RedString("Invalid aggregate. Acceptable values: hour, day"), 1)
+	}
+	period, err := parseReportPeriod(c, defaultTrafficReportPeriod)
+	if err != nil {
+		return cli.NewExitError(color.RedString(err.Error()), 1)
+	}
+	var offset time.Duration
+	if c.IsSet("compare") {
+		offset, err = parsePeriodDuration(c.String("compare"))
+		if err != nil || offset <= 0 {
+			return cli.NewExitError(color.RedString(fmt.Sprintf("Invalid compare offset %s. Must be a duration, e.g. 1d or 1w", c.String("compare"))), 1)
+		}
+	}
+	trafficType := "datacenter"
+	if c.IsSet("property") {
+		trafficType = "property"
+		dom, err := cachedDomain(c, domainName)
+		if err != nil {
+			return cli.NewExitError(color.RedString("Domain "+domainName+" not found "), 1)
+		}
+		var names []string
+		found := false
+		for _, prop := range dom.Properties {
+			names = append(names, prop.Name)
+			found = found || prop.Name == c.String("property")
+		}
+		if !found {
+			return cli.NewExitError(color.RedString(fmt.Sprintf("Property %s not found in domain %s%s", c.String("property"), domainName, suggestionText(closestMatches(names, c.String("property"))))), 1)
+		}
+	} else if err := ResolveDatacenters(c, dcs, domainName, true); err != nil {
+		return cli.NewExitError(color.RedString(err.Error()), 1)
+	}
+
+	window, err := reportWindow(trafficType)
+	if err != nil {
+		return cli.NewExitError(color.RedString(statusErrorText(err)), 1)
+	}
+	start, end, err := period.resolve(window)
+	if err != nil {
+		return cli.NewExitError(color.RedString(err.Error()), 1)
+	}
+	if c.IsSet("compare") && start.Add(-offset).Before(window.StartTime) {
+		return cli.NewExitError(color.RedString(fmt.Sprintf("Comparison period start %s is before the earliest available report data %s", period.format(start.Add(-offset)), period.format(window.StartTime))), 1)
+	}
+
+	report := &TrafficReport{Domain: domainName, Property: c.String("property"), PeriodStart: period.format(start), PeriodEnd: period.format(end), Aggregation: aggregation}
+	fetch := func(from time.Time, to time.Time) ([]*trafficSample, error) {
+		if trafficType == "property" {
+			return fetchPropertyTraffic(domainName, report.Property, from, to)
+		}
+		return fetchDatacenterTraffic(domainName, dcs.flagList, from, to)
+	}
+	interactive := !c.Bool("json") && !c.Bool("csv")
+	if interactive {
+		akamai.StartSpinner("Collecting traffic ", "")
+	}
+	samples, err := fetch(start, end)
+	var prior []*trafficSample
+	if err == nil && c.IsSet("compare") {
+		report.ComparePeriodStart = period.format(start.Add(-offset))
+		report.ComparePeriodEnd = period.format(end.Add(-offset))
+		prior, err = fetch(start.Add(-offset), end.Add(-offset))
+		if prior == nil {
+			prior = []*trafficSample{}
+		}
+	}
+	if err != nil {
+		if interactive {
+			akamai.StopSpinnerFail()
+		}
+		return cli.NewExitError(color.RedString(statusErrorText(err)), 1)
+	}
+	if interactive {
+		akamai.StopSpinnerOk()
+	}
+	buildTrafficReport(report, samples, prior, offset, start, end, period.location)
+
+	if c.Bool("json") {
+		json, err := json.MarshalIndent(report, "", "  ")
+		if err != nil {
+			return cli.NewExitError(color.RedString("Unable to display traffic report"), 1)
+		}
+		fmt.Fprintln(c.App.Writer, string(json))
+	} else if c.Bool("csv") {
+		if err := writeTrafficCSV(c, report); err != nil {
+			return cli.NewExitError(color.RedString("Unable to write traffic report. "+err.Error()), 1)
+		}
+	} else {
+		fmt.Fprintln(c.App.Writer, renderTrafficReport(report))
+		if chartFlags.style != "" {
+			fmt.Fprintln(c.App.Writer, fmt.Sprintf("Requests by %s", report.Aggregation))
+			fmt.Fprintln(c.App.Writer, " ")
+			fmt.Fprintln(c.App.Writer, renderChart(trafficChartData(report), chartFlags.style, period.displayTime))
+		}
+	}
+
+	return nil
+}
+
+// trafficChartData returns the request series of the report buckets by datacenter
+func trafficChartData(report *TrafficReport) *chartData {
+
+	data := newChartData()
+	for _, bucket := range report.Buckets {
+		for _, count := range bucket.Datacenters {
+			label := count.Nickname
+			if label == "" {
+				label = strconv.Itoa(count.DatacenterId)
+			}
+			data.add(label, bucket.Start, count.Requests)
+		}
+	}
+	return data
+}
+
+// formatChange formats a change percentage
+func formatChange(count *TrafficCount) string {
+
+	if count.PriorRequests == nil {
+		return ""
+	}
+	if count.Change == nil {
+		return "n/a"
+	}
+	return fmt.Sprintf("%+.2f%%", *count.Change)
+}
+
+// writeTrafficCSV writes the report buckets as CSV, one row per bucket and datacenter
+func writeTrafficCSV(c *cli.Context, report *TrafficReport) error {
+
+	w := csv.NewWriter(c.App.Writer)
+	compare := report.Total.PriorRequests != nil
+	header := []string{"start", "datacenter_id", "nickname", "requests", "share_percent"}
+	if compare {
+		header = append(header, "prior_requests", "change_percent")
+	}
+	w.Write(header)
+	for _, bucket := range report.Buckets {
+		for _, count := range bucket.Datacenters {
+			record := []string{bucket.Start, strconv.Itoa(count.DatacenterId), count.Nickname, strconv.FormatInt(count.Requests, 10), strconv.FormatFloat(count.Share, 'f', 2, 64)}
+			if compare {
+				change := ""
+				if count.Change != nil {
+					change = strconv.FormatFloat(*count.Change, 'f', 2, 64)
+				}
+				record = append(record, strconv.FormatInt(*count.PriorRequests, 10), change)
+			}
+			w.Write(record)
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// newTrafficTable returns a borderless table writer
+func newTrafficTable(tableString *strings.Builder, header []string) *tablewriter.Table {
+
+	table := tablewriter.NewWriter(tableString)
+	table.SetHeader(header)
+	table.SetAutoFormatHeaders(false)
+	table.SetReflowDuringAutoWrap(false)
+	table.SetCenterSeparator(" ")
+	table.SetColumnSeparator(" ")
+	table.SetRowSeparator(" ")
+	table.SetBorder(false)
+	table.SetAutoWrapText(false)
+	table.SetAlignment(tablewriter.ALIGN_RIGHT)
+	return table
+}
+
+// Pretty print traffic report
+func renderTrafficReport(report *TrafficReport) string {
+
+	compare := report.Total.PriorRequests != nil
+	var outString string
+	outString += fmt.Sprintln(" ")
+	outString += fmt.Sprintln("Domain: ", report.Domain)
+	if report.Property != "" {
+		outString += fmt.Sprintln("Property: ", report.Property)
+	}
+	outString += fmt.Sprintln("Period Start: ", report.PeriodStart)
+	outString += fmt.Sprintln("Period End: ", report.PeriodEnd)
+	if compare {
+		outString += fmt.Sprintln("Compared With: ", report.ComparePeriodStart, "-", report.ComparePeriodEnd)
+	}
+	outString += fmt.Sprintln(" ")
+
+	// Datacenter totals
+	header := []string{"Datacenter", "Nickname", "Requests", "Share"}
+	if compare {
+		header = append(header, "Prior Requests", "Change")
+	}
+	tableString := &strings.Builder{}
+	table := newTrafficTable(tableString, header)
+	rows := append(append([]*TrafficCount{}, report.Datacenters...), &TrafficCount{Nickname: "Total", Requests: report.Total.Requests, Share: report.Total.Share, PriorRequests: report.Total.PriorRequests, Change: report.Total.Change})
+	for _, count := range rows {
+		dcId := strconv.Itoa(count.DatacenterId)
+		if count.DatacenterId == 0 {
+			dcId = " "
+		}
+		rowData := []string{dcId, count.Nickname, strconv.FormatInt(count.Requests, 10), fmt.Sprintf("%.2f%%", count.Share)}
+		if compare {
+			rowData = append(rowData, strconv.FormatInt(*count.PriorRequests, 10), formatChange(count))
+		}
+		table.Append(rowData)
+	}
+	table.Render()
+	outString += fmt.Sprintln(tableString.String())
+
+	// Requests by hour or day
+	outString += fmt.Sprintln(fmt.Sprintf("Requests by %s", report.Aggregation))
+	outString += fmt.Sprintln(" ")
+	header = []string{"Start"}
+	for _, count := range report.Datacenters {
+		label := count.Nickname
+		if label == "" {
+			label = strconv.Itoa(count.DatacenterId)
+		}
+		header = append(header, label)
+	}
+	header = append(header, "Total")
+	if compare {
+		header = append(header, "Prior Total", "Change")
+	}
+	tableString = &strings.Builder{}
+	table = newTrafficTable(tableString, header)
+	if len(report.Buckets) == 0 {
+		rowData := make([]string, len(header))
+		rowData[0] = "No traffic data available"
+		table.Append(rowData)
+	}
+	for _, bucket := range report.Buckets {
+		rowData := []string{bucket.Start}
+		for _, count := range bucket.Datacenters {
+			rowData = append(rowData, fmt.Sprintf("%d (%.1f%%)", count.Requests, count.Share))
+		}
+		rowData = append(rowData, strconv.FormatInt(bucket.Total.Requests, 10))
+		if compare {
+			rowData = append(rowData, strconv.FormatInt(*bucket.Total.PriorRequests, 10), formatChange(bucket.Total))
+		}
+		table.Append(rowData)
+	}
+	table.Render()
+	outString += fmt.Sprintln(tableString.String())
+
+	return outString
+}
@@ -0,0 +1,145 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBucketStart(t *testing.T) {
+
+	est := time.FixedZone("EST", -5*3600)
+	tests := []struct {
+		name        string
+		ts          string
+		aggregation string
+		loc         *time.Location
+		want        string
+	}{
+		{"hour", "2026-03-10T05:35:12Z", "hour", time.UTC, "2026-03-10T05:00:00Z"},
+		{"day", "2026-03-10T05:35:12Z", "day", time.UTC, "2026-03-10T00:00:00Z"},
+		{"day in timezone", "2026-03-10T03:00:00Z", "day", est, "2026-03-09T00:00:00-05:00"},
+		{"hour in timezone", "2026-03-10T03:20:00Z", "hour", est, "2026-03-09T22:00:00-05:00"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := bucketStart(mustParseTime(t, tt.ts), tt.aggregation, tt.loc)
+			if got.Format(time.RFC3339) != tt.want {
+				t.Errorf("bucketStart() = %s, want %s", got.Format(time.RFC3339), tt.want)
+			}
+			if next := nextBucket(got, tt.aggregation); !next.After(got) || bucketStart(next, tt.aggregation, tt.loc) != next {
+				t.Errorf("nextBucket() = %s", next.Format(time.RFC3339))
+			}
+		})
+	}
+}
+
+func TestCompareCount(t *testing.T) {
+
+	tests := []struct {
+		name       string
+		requests   int64
+		prior      int64
+		wantChange string
+	}{
+		{"increase", 150, 100, "+50.00%"},
+		{"decrease", 25, 100, "-75.00%"},
+		{"unchanged", 100, 100, "+0.00%"},
+		{"no prior requests", 100, 0, "n/a"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			count := &TrafficCount{Requests: tt.requests}
+			if got := formatChange(count); got != "" {
+				t.Fatalf("formatChange() before compare = %q", got)
+			}
+			compareCount(count, tt.prior)
+			if *count.PriorRequests != tt.prior {
+				t.Errorf("prior requests %d, want %d", *count.PriorRequests, tt.prior)
+			}
+			if got := formatChange(count); got != tt.wantChange {
+				t.Errorf("formatChange() = %q, want %q", got, tt.wantChange)
+			}
+		})
+	}
+}
+
+func TestBuildTrafficReport(t *testing.T) {
+
+	sample := func(ts string, dcId int, requests int64) *trafficSample {
+		return &trafficSample{timestamp: mustParseTime(t, ts), datacenterId: dcId, nickname: map[int]string{3131: "east", 3132: "west"}[dcId], requests: requests}
+	}
+	samples := []*trafficSample{
+		sample("2026-03-10T00:05:00Z", 3132, 30),
+		sample("2026-03-10T00:10:00Z", 3131, 10),
+		sample("2026-03-10T00:55:00Z", 3131, 20),
+		sample("2026-03-10T02:00:00Z", 3131, 40),
+	}
+	start := mustParseTime(t, "2026-03-10T00:00:00Z")
+	end := mustParseTime(t, "2026-03-10T03:00:00Z")
+
+	report := &TrafficReport{Aggregation: "hour"}
+	buildTrafficReport(report, samples, nil, 0, start, end, time.UTC)
+	if report.Total.Requests != 100 || report.Total.PriorRequests != nil {
+		t.Fatalf("total %+v", report.Total)
+	}
+	if len(report.Datacenters) != 2 || report.Datacenters[0].DatacenterId != 3131 || report.Datacenters[0].Nickname != "east" ||
+		report.Datacenters[0].Requests != 70 || report.Datacenters[0].Share != 70 || report.Datacenters[1].Share != 30 {
+		t.Fatalf("datacenters %+v %+v", report.Datacenters[0], report.Datacenters[1])
+	}
+	wantBuckets := []struct {
+		start    string
+		requests int64
+		share    float64
+		east     int64
+	}{
+		{"2026-03-10T00:00:00Z", 60, 60, 30},
+		{"2026-03-10T01:00:00Z", 0, 0, 0},
+		{"2026-03-10T02:00:00Z", 40, 40, 40},
+	}
+	if len(report.Buckets) != len(wantBuckets) {
+		t.Fatalf("%d buckets, want %d", len(report.Buckets), len(wantBuckets))
+	}
+	for i, want := range wantBuckets {
+		bucket := report.Buckets[i]
+		if bucket.Start != want.start || bucket.Total.Requests != want.requests || bucket.Total.Share != want.share || bucket.Datacenters[0].Requests != want.east {
+			t.Errorf("bucket %d = %s %+v %+v, want %+v", i, bucket.Start, bucket.Total, bucket.Datacenters[0], want)
+		}
+	}
+	if share := report.Buckets[0].Datacenters[0].Share; share != 50 {
+		t.Errorf("bucket datacenter share %v, want 50", share)
+	}
+
+	// prior samples a day earlier are shifted into the current buckets
+	prior := []*trafficSample{
+		sample("2026-03-09T00:30:00Z", 3131, 15),
+		sample("2026-03-09T02:30:00Z", 3131, 80),
+	}
+	report = &TrafficReport{Aggregation: "day"}
+	buildTrafficReport(report, samples, prior, 24*time.Hour, start, end, time.UTC)
+	if len(report.Buckets) != 1 || report.Buckets[0].Start != "2026-03-10T00:00:00Z" {
+		t.Fatalf("buckets %+v", report.Buckets)
+	}
+	if *report.Total.PriorRequests != 95 || formatChange(report.Total) != "+5.26%" {
+		t.Errorf("total %d prior %d change %s", report.Total.Requests, *report.Total.PriorRequests, formatChange(report.Total))
+	}
+	if east := report.Datacenters[0]; *east.PriorRequests != 95 || formatChange(east) != "-26.32%" {
+		t.Errorf("east prior %d change %s", *east.PriorRequests, formatChange(east))
+	}
+	if west := report.Datacenters[1]; *west.PriorRequests != 0 || formatChange(west) != "n/a" {
+		t.Errorf("west prior %d change %s", *west.PriorRequests, formatChange(west))
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	akamai "github.com/akamai/cli-common-golang"
+	"github.com/fatih/color"
+	"github.com/urfave/cli"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// livenessTestSelector captures the criteria used to select liveness tests across properties
+type livenessTestSelector struct {
+	namePattern *regexp.Regexp
+	protocols   []string
+	testObject  string
+}
+
+// matches returns true if the liveness test satisfies all specified selection criteria
+func (s *livenessTestSelector) matches(test *configgtm.LivenessTest) bool {
+
+	if s.namePattern != nil && !s.namePattern.MatchString(test.Name) {
+		return false
+	}
+	if len(s.protocols) > 0 {
+		protoMatch := false
+		for _, proto := range s.protocols {
+			if strings.EqualFold(proto, test.TestObjectProtocol) {
+				protoMatch = true
+				break
+			}
+		}
+		if !protoMatch {
+			return false
+		}
+	}
+	if s.testObject != "" && s.testObject != test.TestObject {
+		return false
+	}
+	return true
+}
+
+// applyLivenessTestChanges applies the requested field changes to a liveness test. Returns true if the test was modified.
+func applyLivenessTestChanges(c *cli.Context, test *configgtm.LivenessTest) bool {
+
+	changes_made := false
+	if c.IsSet("path") && test.TestObject != c.String("path") {
+		test.TestObject = c.String("path")
+		changes_made = true
+	}
+	if c.IsSet("port") && test.TestObjectPort != c.Int("port") {
+		test.TestObjectPort = c.Int("port")
+		changes_made = true
+	}
+	if c.IsSet("interval") && test.TestInterval != c.Int("interval") {
+		test.TestInterval = c.Int("interval")
+		changes_made = true
+	}
+	if c.IsSet("test-timeout") && test.TestTimeout != float32(c.Float64("test-timeout")) {
+		test.TestTimeout = float32(c.Float64("test-timeout"))
+		changes_made = true
+	}
+	if c.IsSet("host-header") {
+		hostHeader := c.String("host-header")
+		found := false
+		for _, hdr := range test.HttpHeaders {
+			if strings.EqualFold(hdr.Name, "Host") {
+				found = true
+				if hdr.Value != hostHeader {
+					hdr.Value = hostHeader
+					changes_made = true
+				}
+			}
+		}
+		if !found {
+			hdr := test.NewHttpHeader()
+			hdr.Name = "Host"
+			hdr.Value = hostHeader
+			test.HttpHeaders = append(test.HttpHeaders, hdr)
+			changes_made = true
+		}
+	}
+	if c.IsSet("enable") || c.IsSet("disable") {
+		// logic is reversed.
+		disabled := c.IsSet("disable")
+		if test.Disabled != disabled {
+			test.Disabled = disabled
+			changes_made = true
+		}
+	}
+	return changes_made
+}
+
+// worker function for update-liveness-tests
+func cmdUpdateLivenessTests(c *cli.Context) error {
+
+	var ltTimeout int = defaultTimeout
+	var ltDryrun bool = false
+	var ltComplete bool = false
+
+	config, err := akamai.GetEdgegridConfig(c)
+	if err != nil {
+		return err
+	}
+
+	configgtm.Init(config)
+
+	if c.NArg() == 0 {
+		cli.ShowCommandHelp(c, c.Command.Name)
+		return cli.NewExitError(color.RedString("domain name is required"), 1)
+	}
+
+	domainName := c.Args().First()
+	if c.IsSet("enable") && c.IsSet("disable") {
+		return cli.NewExitError(color.RedString("must specified either enable or disable."), 1)
+	}
+	if c.IsSet("verbose") {
+		verboseStatus = true
+	}
+	if c.IsSet("complete") {
+		ltComplete = true
+	}
+	if c.IsSet("dryrun") {
+		ltDryrun = true
+	}
+	if c.IsSet("timeout") {
+		ltTimeout = c.Int("timeout")
+	}
+
+	selector := &livenessTestSelector{protocols: c.StringSlice("protocol"), testObject: c.String("test-object")}
+	if c.IsSet("name") {
+		selector.namePattern, err = regexp.Compile(c.String("name"))
+		if err != nil {
+			return cli.NewExitError(color.RedString("Invalid liveness test name pattern. "+err.Error()), 1)
+		}
+	}
+	if !c.IsSet("name") && !c.IsSet("protocol") && !c.IsSet("test-object") {
+		cli.ShowCommandHelp(c, c.Command.Name)
+		return cli.NewExitError(color.RedString("name, protocol and/or test-object selector must be specified"), 1)
+	}
+	if !(c.IsSet("path") || c.IsSet("port") || c.IsSet("interval") || c.IsSet("test-timeout") || c.IsSet("host-header") || c.IsSet("enable") || c.IsSet("disable")) {
+		cli.ShowCommandHelp(c, c.Command.Name)
+		return cli.NewExitError(color.RedString("liveness test(s) specified with no field changes"), 1)
+	}
+	if c.IsSet("port") && (c.Int("port") < 1 || c.Int("port") > 65535) {
+		return cli.NewExitError(color.RedString("port must be between 1 and 65535"), 1)
+	}
+	if c.IsSet("interval") && c.Int("interval") < 1 {
+		return cli.NewExitError(color.RedString("interval must be a positive number of seconds"), 1)
+	}
+	if c.IsSet("test-timeout") && c.Float64("test-timeout") <= 0 {
+		return cli.NewExitError(color.RedString("test-timeout must be a positive number of seconds"), 1)
+	}
+
+	if !c.IsSet("json") {
+		fmt.Println(fmt.Sprintf("Updating Liveness Tests in domain %s ", domainName))
+	}
+
+	dom, err := configgtm.GetDomain(domainName)
+	if err != nil {
+		return cli.NewExitError(color.RedString("Domain "+domainName+" not found "), 1)
+	}
+	properties := dom.Properties
+	propmsg := fmt.Sprintf("%s contains %s properties", domainName, strconv.Itoa(len(properties)))
+	if !c.IsSet("json") {
+		fmt.Println(propmsg)
+	}
+	plan := newChangePlan(c, domainName, ltDryrun)
+	for _, propPtr := range properties {
+		changes_made := false
+		for _, test := range propPtr.LivenessTests {
+			if !selector.matches(test) {
+				continue
+			}
+			if applyLivenessTestChanges(c, test) {
+				changes_made = true
+			}
+		}
+		if changes_made {
+			plan.addProperty(propPtr, targetEnabledState(propPtr), targetWeights(propPtr))
+		}
+	}
+
+	if err := enforcePolicy(c, plan); err != nil {
+		return err
+	}
+	if err := confirmPlan(c, plan); err != nil {
+		return err
+	}
+	for _, propPtr := range plan.properties {
+		if !c.IsSet("json") {
+			akamai.StartSpinner(fmt.Sprintf("Updating Property: %s", propPtr.Name), "")
+		}
+		recordPropertyUpdate(c, domainName, propPtr, ltDryrun)
+		if !c.IsSet("json") {
+			akamai.StopSpinnerOk()
+		}
+	}
+
+	if ltComplete && (len(succVerboseArray) > 0 || len(succShortArray) > 0) {
+		waitForDomainCompletion(c, domainName, ltTimeout)
+	}
+
+	return renderUpdateSummary(c, "Liveness Test Update Summary", len(properties), ltDryrun)
+
+}
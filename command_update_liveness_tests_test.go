@@ -0,0 +1,97 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	"regexp"
+	"testing"
+)
+
+func TestLivenessTestSelectorMatches(t *testing.T) {
+
+	test := &configgtm.LivenessTest{Name: "health-https", TestObjectProtocol: "HTTPS", TestObject: "/status"}
+	tests := []struct {
+		name     string
+		selector livenessTestSelector
+		want     bool
+	}{
+		{"no criteria", livenessTestSelector{}, true},
+		{"name match", livenessTestSelector{namePattern: regexp.MustCompile("^health")}, true},
+		{"name mismatch", livenessTestSelector{namePattern: regexp.MustCompile("^ping")}, false},
+		{"protocol case insensitive", livenessTestSelector{protocols: []string{"http", "https"}}, true},
+		{"protocol mismatch", livenessTestSelector{protocols: []string{"TCP"}}, false},
+		{"test object match", livenessTestSelector{testObject: "/status"}, true},
+		{"test object mismatch", livenessTestSelector{testObject: "/health"}, false},
+		{"all criteria", livenessTestSelector{namePattern: regexp.MustCompile("https"), protocols: []string{"HTTPS"}, testObject: "/status"}, true},
+		{"one criterion fails", livenessTestSelector{namePattern: regexp.MustCompile("https"), protocols: []string{"HTTP"}, testObject: "/status"}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.selector.matches(test); got != tt.want {
+				t.Errorf("matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestApplyLivenessTestChanges(t *testing.T) {
+
+	newTest := func() *configgtm.LivenessTest {
+		return &configgtm.LivenessTest{TestObject: "/status", TestObjectPort: 443, TestInterval: 60, TestTimeout: 10,
+			HttpHeaders: []*configgtm.HttpHeader{{Name: "host", Value: "www.example.com"}}}
+	}
+	tests := []struct {
+		name    string
+		args    []string
+		changed bool
+		check   func(test *configgtm.LivenessTest) bool
+	}{
+		{"no flags", nil, false, func(test *configgtm.LivenessTest) bool { return test.TestObject == "/status" }},
+		{"path", []string{"--path", "/health"}, true, func(test *configgtm.LivenessTest) bool { return test.TestObject == "/health" }},
+		{"same path", []string{"--path", "/status"}, false, func(test *configgtm.LivenessTest) bool { return test.TestObject == "/status" }},
+		{"port", []string{"--port", "8443"}, true, func(test *configgtm.LivenessTest) bool { return test.TestObjectPort == 8443 }},
+		{"interval", []string{"--interval", "30"}, true, func(test *configgtm.LivenessTest) bool { return test.TestInterval == 30 }},
+		{"test timeout", []string{"--test-timeout", "2.5"}, true, func(test *configgtm.LivenessTest) bool { return test.TestTimeout == 2.5 }},
+		{"existing host header", []string{"--host-header", "api.example.com"}, true, func(test *configgtm.LivenessTest) bool {
+			return len(test.HttpHeaders) == 1 && test.HttpHeaders[0].Value == "api.example.com"
+		}},
+		{"same host header", []string{"--host-header", "www.example.com"}, false, func(test *configgtm.LivenessTest) bool { return len(test.HttpHeaders) == 1 }},
+		{"disable", []string{"--disable"}, true, func(test *configgtm.LivenessTest) bool { return test.Disabled }},
+		{"enable", []string{"--enable"}, false, func(test *configgtm.LivenessTest) bool { return !test.Disabled }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			test := newTest()
+			c := newTestContext(t, "update-liveness-tests", tt.args...)
+			if got := applyLivenessTestChanges(c, test); got != tt.changed {
+				t.Errorf("applyLivenessTestChanges() = %v, want %v", got, tt.changed)
+			}
+			if !tt.check(test) {
+				t.Errorf("unexpected liveness test %+v", test)
+			}
+		})
+	}
+
+	// host header added when absent
+	test := newTest()
+	test.HttpHeaders = nil
+	if !applyLivenessTestChanges(newTestContext(t, "update-liveness-tests", "--host-header", "api.example.com"), test) {
+		t.Fatal("expected change")
+	}
+	if len(test.HttpHeaders) != 1 || test.HttpHeaders[0].Name != "Host" || test.HttpHeaders[0].Value != "api.example.com" {
+		t.Errorf("unexpected headers %+v", test.HttpHeaders)
+	}
+}
@@ -18,10 +18,14 @@ import (
 	"encoding/json"
 	"fmt"
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/reportsgtm-v1"
 	akamai "github.com/akamai/cli-common-golang"
 	"github.com/fatih/color"
 	"github.com/olekukonko/tablewriter"
 	"github.com/urfave/cli"
+	"net"
+	"reflect"
+	"regexp"
 	"sort"
 	"strconv"
 	"strings"
@@ -38,6 +42,9 @@ func cmdUpdateProperty(c *cli.Context) error {
 	var pTargets *TargetFlags
 	var pServers []string
 	var pLivenessTests []string
+	var pLivenessTestPatterns []*regexp.Regexp
+	var pSelectedTests []*configgtm.LivenessTest
+	var pSettings []*propertySetting
 	var pEnabled bool = true
 	var pDatacenters *arrayFlags
 	var pComplete bool = false
@@ -49,6 +56,7 @@ func cmdUpdateProperty(c *cli.Context) error {
 	}
 
 	configgtm.Init(config)
+	reportsgtm.Init(config)
 
 	if c.NArg() < 2 {
 		cli.ShowCommandHelp(c, c.Command.Name)
@@ -60,9 +68,23 @@ func cmdUpdateProperty(c *cli.Context) error {
 
 	// Changes may be to enabled, weight or servers
 	pWeight = c.Float64("weight")
-	pServers = c.StringSlice("server")
+	pServers = dedupServers(c.StringSlice("server"))
+	pAddServers := dedupServers(c.StringSlice("add-server"))
+	pRemoveServers := dedupServers(c.StringSlice("remove-server"))
+	serverChangesSpecified := c.IsSet("server") || c.IsSet("add-server") || c.IsSet("remove-server")
 	pLivenessTests = c.StringSlice("liveness_test")
-	fmt.Println("pLivenessTests: ", pLivenessTests)
+	for _, pattern := range c.StringSlice("liveness-test-regex") {
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return cli.NewExitError(color.RedString(fmt.Sprintf("Invalid liveness test pattern %s. %s", pattern, err.Error())), 1)
+		}
+		pLivenessTestPatterns = append(pLivenessTestPatterns, re)
+	}
+	livenessTestsSpecified := c.IsSet("liveness_test") || c.IsSet("liveness-test-regex") || c.IsSet("all-liveness-tests")
+	pSettings, err = parsePropertySettings(c.StringSlice("set"))
+	if err != nil {
+		return cli.NewExitError(color.RedString(err.Error()), 1)
+	}
 	if c.IsSet("enable") && c.IsSet("disable") {
 		return cli.NewExitError(color.RedString("must specified either enable or disable."), 1)
 	} else if c.IsSet("enable") {
@@ -84,31 +106,27 @@ func cmdUpdateProperty(c *cli.Context) error {
 	if c.IsSet("timeout") {
 		pTimeout = c.Int("timeout")
 	}
-	if c.IsSet("datacenter") && c.IsSet("liveness_test") && (c.IsSet("enable") || c.IsSet("disable")) {
+	if c.IsSet("datacenter") && livenessTestsSpecified && (c.IsSet("enable") || c.IsSet("disable")) {
 		return cli.NewExitError(color.RedString("enable/disable can only be applied to either datacenter(s) OR liveness_test(s)"), 1)
 	}
-	if !c.IsSet("target") && !c.IsSet("datacenter") && !c.IsSet("liveness_test") {
-		return cli.NewExitError(color.RedString("datacenter(s), target(s) and/or liveness_test(s)s must be specified"), 1)
+	if !c.IsSet("target") && !c.IsSet("datacenter") && !livenessTestsSpecified && !c.IsSet("set") {
+		return cli.NewExitError(color.RedString("datacenter(s), target(s), liveness_test(s) and/or property setting(s) must be specified"), 1)
 	}
-	// if nicknames specified, add to dcFlags
-	err = ParseNicknames(pDatacenters.nicknamesList, domainName)
+	// resolve datacenter ids and nicknames
+	err = ResolveDatacenters(c, pDatacenters, domainName, false)
 	if err != nil {
-		if verboseStatus {
-			return cli.NewExitError(color.RedString("Unable to retrieve datacenter list. "+err.Error()), 1)
-		} else {
-			return cli.NewExitError(color.RedString("Unable to retrieve datacenter."), 1)
-		}
+		return cli.NewExitError(color.RedString(err.Error()), 1)
 	}
-	if !c.IsSet("datacenter") && !c.IsSet("liveness_test") && (c.IsSet("enable") || c.IsSet("disable")) {
+	if !c.IsSet("datacenter") && !livenessTestsSpecified && (c.IsSet("enable") || c.IsSet("disable")) {
 		return cli.NewExitError(color.RedString("datacenter(s) or liveness_test(s) must be specified when enable or disable are specified"), 1)
 	}
-	if !c.IsSet("datacenter") && (c.IsSet("server") || c.IsSet("weight")) {
+	if !c.IsSet("datacenter") && (serverChangesSpecified || c.IsSet("weight")) {
 		return cli.NewExitError(color.RedString("datacenter(s) must be specified when server or weight field changes are specified"), 1)
 	}
-	if c.IsSet("liveness_test") && !(c.IsSet("enable") || c.IsSet("disable")) {
+	if livenessTestsSpecified && !(c.IsSet("enable") || c.IsSet("disable")) {
 		return cli.NewExitError(color.RedString("liveness_test(s) specified without enable or disable directive"), 1)
 	}
-	if c.IsSet("datacenter") && !(c.IsSet("server") || c.IsSet("weight") || c.IsSet("enable") || c.IsSet("disable")) {
+	if c.IsSet("datacenter") && !(serverChangesSpecified || c.IsSet("weight") || c.IsSet("enable") || c.IsSet("disable")) {
 		return cli.NewExitError(color.RedString("datacenter(s) specified with no field changes"), 1)
 	}
 	for _, dcID := range pDatacenters.flagList {
@@ -119,6 +137,16 @@ func cmdUpdateProperty(c *cli.Context) error {
 	if c.IsSet("server") && len(pDatacenters.flagList) > 1 {
 		return cli.NewExitError(color.RedString("server update may only apply to one datacenter"), 1)
 	}
+	if c.IsSet("server") && (c.IsSet("add-server") || c.IsSet("remove-server")) {
+		return cli.NewExitError(color.RedString("server cannot be combined with add-server or remove-server"), 1)
+	}
+	for _, addServer := range pAddServers {
+		for _, removeServer := range pRemoveServers {
+			if addServer == removeServer {
+				return cli.NewExitError(color.RedString(fmt.Sprintf("server %s cannot be both added and removed", addServer)), 1)
+			}
+		}
+	}
 	if c.IsSet("weight") && len(pDatacenters.flagList) > 1 {
 		return cli.NewExitError(color.RedString("weight update may only apply to one datacenter"), 1)
 	}
@@ -133,13 +161,34 @@ func cmdUpdateProperty(c *cli.Context) error {
 
 	changes_made := false
 	trafficTargets := property.TrafficTargets
+	enabledBefore := targetEnabledState(property)
+	weightsBefore := targetWeights(property)
 	targetsmsg := fmt.Sprintf("%s contains %s targets", property.Name, strconv.Itoa(len(trafficTargets)))
 	if !c.IsSet("json") {
 		fmt.Println(targetsmsg)
 	}
-	fmt.Sprintf(targetsmsg)
+	// property level settings. Applied first as ipv6 determines valid server addresses.
+	ipv6Before := property.Ipv6
+	if applyPropertySettings(property, pSettings) {
+		changes_made = true
+	}
+	if err := validateServerAddresses(pAddServers, property.Ipv6); err != nil {
+		return cli.NewExitError(color.RedString(err.Error()), 1)
+	}
+	if notFound := missingServers(property, pDatacenters.flagList, pRemoveServers); len(notFound) > 0 {
+		return cli.NewExitError(color.RedString(fmt.Sprintf("Server(s) to remove not found in specified datacenter target(s): %s", strings.Join(notFound, ", "))), 1)
+	}
+	// resolve liveness tests before making any changes
+	if livenessTestsSpecified {
+		pSelectedTests, err = selectLivenessTests(property, pLivenessTests, pLivenessTestPatterns, c.IsSet("all-liveness-tests"))
+		if err != nil {
+			return cli.NewExitError(color.RedString(err.Error()), 1)
+		}
+	}
 	akamai.StartSpinner("Updating Traffic Targets ", "")
 	var propTargets = map[int]string{}
+	// datacenters of targets with edited server lists
+	editedTargets := map[int]bool{}
 	for _, traffTarg := range trafficTargets {
 		// Al traffic target fields can be updated via target.
 		if c.IsSet("target") {
@@ -160,6 +209,7 @@ func cmdUpdateProperty(c *cli.Context) error {
 					if len(targ.Servers) > 0 {
 						if len(targ.Servers) != len(traffTarg.Servers) {
 							traffTarg.Servers = targ.Servers
+							editedTargets[traffTarg.DatacenterId] = true
 							changes_made = true
 						} else {
 							sort.Strings(targ.Servers)
@@ -167,6 +217,7 @@ func cmdUpdateProperty(c *cli.Context) error {
 							for i, v := range traffTarg.Servers {
 								if v != targ.Servers[i] {
 									traffTarg.Servers = targ.Servers
+									editedTargets[traffTarg.DatacenterId] = true
 									changes_made = true
 								}
 							}
@@ -188,7 +239,6 @@ func cmdUpdateProperty(c *cli.Context) error {
 
 		for _, dcID := range pDatacenters.flagList {
 			if traffTarg.DatacenterId == dcID {
-				fmt.Sprintf("%s contains dc %s", traffTarg.Name, strconv.Itoa(dcID))
 				if (c.IsSet("enable") || c.IsSet("disable")) && traffTarg.Enabled != pEnabled {
 					traffTarg.Enabled = pEnabled
 					changes_made = true
@@ -200,8 +250,16 @@ func cmdUpdateProperty(c *cli.Context) error {
 				}
 				if c.IsSet("server") {
 					traffTarg.Servers = pServers
+					editedTargets[traffTarg.DatacenterId] = true
 					changes_made = true
 				}
+				if c.IsSet("add-server") || c.IsSet("remove-server") {
+					if servers, changed := editServerList(traffTarg.Servers, pAddServers, pRemoveServers); changed {
+						traffTarg.Servers = servers
+						editedTargets[traffTarg.DatacenterId] = true
+						changes_made = true
+					}
+				}
 			}
 		}
 	}
@@ -214,6 +272,7 @@ func cmdUpdateProperty(c *cli.Context) error {
 				for _, t := range pTargets.targets {
 					if t.DatacenterId == cmdTarget {
 						property.TrafficTargets = append(property.TrafficTargets, &t)
+						editedTargets[t.DatacenterId] = true
 						changes_made = true
 						break
 					}
@@ -222,27 +281,50 @@ func cmdUpdateProperty(c *cli.Context) error {
 		}
 	}
 
+	// validate resulting server lists of specified datacenters
+	if serverChangesSpecified {
+		if err := validateTargetServers(property, pDatacenters.flagList); err != nil {
+			akamai.StopSpinnerFail()
+			return cli.NewExitError(color.RedString(err.Error()), 1)
+		}
+	}
+
+	// validate server addresses of edited targets. An ipv6 change applies to all targets.
+	if property.Ipv6 != ipv6Before {
+		for _, traffTarg := range property.TrafficTargets {
+			editedTargets[traffTarg.DatacenterId] = true
+		}
+	}
+	if err := validateTargetAddresses(property, editedTargets); err != nil {
+		akamai.StopSpinnerFail()
+		return cli.NewExitError(color.RedString(err.Error()), 1)
+	}
+
 	// enable/disable property liveness tests?
-	if len(pLivenessTests) > 0 {
-		testList := strings.Join(pLivenessTests, " ")
-		fmt.Println("livesness tests: ", testList)
-		for _, test := range property.LivenessTests {
-			fmt.Println("Processing livesness test: ", test.Name)
-			if strings.Contains(testList, test.Name) {
-				fmt.Println("Livesness test match!")
-				fmt.Println("pEnabled: ", pEnabled)
-				fmt.Println("test.Disabled: ", test.Disabled)
-				if (c.IsSet("enable") || c.IsSet("disable")) && test.Disabled != !pEnabled {
-					// logic is reversed.
-					test.Disabled = !pEnabled
-					changes_made = true
-				}
-			}
+	for _, test := range pSelectedTests {
+		if (c.IsSet("enable") || c.IsSet("disable")) && test.Disabled != !pEnabled {
+			// logic is reversed.
+			test.Disabled = !pEnabled
+			changes_made = true
+		}
+	}
+
+	if changes_made && !c.IsSet("force") {
+		if err := checkPropertySafeguards(domainName, property, enabledBefore); err != nil {
+			akamai.StopSpinnerFail()
+			return cli.NewExitError(color.RedString(err.Error()), 1)
 		}
 	}
 
 	if changes_made {
 
+		plan := newChangePlan(c, domainName, pDryrun)
+		plan.addProperty(property, enabledBefore, weightsBefore)
+		if err := enforcePolicy(c, plan); err != nil {
+			akamai.StopSpinnerFail()
+			return err
+		}
+
 		if pDryrun {
 			json, err := json.MarshalIndent(property, "", "  ")
 			if err != nil {
@@ -258,11 +340,18 @@ func cmdUpdateProperty(c *cli.Context) error {
 			return nil
 		}
 
+		// confirmation prompt may not share the terminal with the spinner
+		akamai.StopSpinnerOk()
+		if err := confirmPlan(c, plan); err != nil {
+			return err
+		}
+		akamai.StartSpinner(fmt.Sprintf("Updating Property: %s ", propertyName), "")
 		propStat, err := property.Update(domainName)
 		if err != nil {
 			akamai.StopSpinnerFail()
 			return cli.NewExitError(color.RedString(fmt.Sprintf("Error updating property %s. %s", propertyName, err.Error())), 1)
 		}
+		invalidateDomainCache(c, domainName)
 		if !c.IsSet("json") {
 			akamai.StopSpinnerOk()
 		}
@@ -343,6 +432,329 @@ func cmdUpdateProperty(c *cli.Context) error {
 
 }
 
+// propertySetting represents a validated property field change specified by --set key=value
+type propertySetting struct {
+	key   string
+	field string
+	value interface{}
+}
+
+// updatablePropertySettings lists the property settings which may be changed with --set, keyed by json name
+var updatablePropertySettings = []string{"handoutMode", "handoutLimit", "failoverDelay", "failbackDelay", "dynamicTTL", "staticTTL",
+	"scoreAggregationType", "stickinessBonusPercentage", "backupCName", "backupIp", "ipv6"}
+
+var validHandoutModes = []string{"normal", "persistent", "one-ip", "one-ip-hashed", "all-live-ips"}
+var validScoreAggregationTypes = []string{"mean", "median", "best", "worst"}
+
+// propertyFieldByJSONName returns the configgtm.Property struct field with the given json name
+func propertyFieldByJSONName(jsonName string) (reflect.StructField, bool) {
+
+	propType := reflect.TypeOf(configgtm.Property{})
+	for i := 0; i < propType.NumField(); i++ {
+		field := propType.Field(i)
+		tagName := strings.Split(field.Tag.Get("json"), ",")[0]
+		if tagName == jsonName {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
+
+// parsePropertySettings validates key=value settings, converting each value to the type of the corresponding property field
+func parsePropertySettings(settings []string) ([]*propertySetting, error) {
+
+	var parsed []*propertySetting
+	for _, setting := range settings {
+		kv := strings.SplitN(setting, "=", 2)
+		if len(kv) != 2 || kv[0] == "" {
+			return nil, fmt.Errorf("Invalid setting %s. Settings must be specified as key=value", setting)
+		}
+		key := strings.TrimSpace(kv[0])
+		val := strings.TrimSpace(kv[1])
+		supported := false
+		for _, k := range updatablePropertySettings {
+			if k == key {
+				supported = true
+				break
+			}
+		}
+		field, ok := propertyFieldByJSONName(key)
+		if !supported || !ok {
+			return nil, fmt.Errorf("Unsupported property setting %s. Supported settings: %s", key, strings.Join(updatablePropertySettings, ", "))
+		}
+		for _, p := range parsed {
+			if p.key == key {
+				return nil, fmt.Errorf("Property setting %s specified more than once", key)
+			}
+		}
+		pSetting := &propertySetting{key: key, field: field.Name}
+		switch field.Type.Kind() {
+		case reflect.Int:
+			intVal, err := strconv.Atoi(val)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid value for %s: %s. Value must be an integer", key, val)
+			}
+			if intVal < 0 {
+				return nil, fmt.Errorf("Invalid value for %s: %s. Value must not be negative", key, val)
+			}
+			pSetting.value = intVal
+		case reflect.Float64:
+			floatVal, err := strconv.ParseFloat(val, 64)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid value for %s: %s. Value must be a number", key, val)
+			}
+			pSetting.value = floatVal
+		case reflect.Bool:
+			boolVal, err := parseBoolString(val)
+			if err != nil {
+				return nil, fmt.Errorf("Invalid value for %s: %s. %s", key, val, err.Error())
+			}
+			pSetting.value = boolVal
+		case reflect.String:
+			pSetting.value = val
+		default:
+			return nil, fmt.Errorf("Property setting %s cannot be updated", key)
+		}
+		if err := validatePropertySetting(pSetting); err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, pSetting)
+	}
+
+	return parsed, nil
+}
+
+// validatePropertySetting applies value checks beyond type for specific settings
+func validatePropertySetting(setting *propertySetting) error {
+
+	contains := func(list []string, val string) bool {
+		for _, v := range list {
+			if v == val {
+				return true
+			}
+		}
+		return false
+	}
+	switch setting.key {
+	case "handoutMode":
+		if !contains(validHandoutModes, setting.value.(string)) {
+			return fmt.Errorf("Invalid value for handoutMode: %s. Acceptable values: %s", setting.value, strings.Join(validHandoutModes, ", "))
+		}
+	case "scoreAggregationType":
+		if !contains(validScoreAggregationTypes, setting.value.(string)) {
+			return fmt.Errorf("Invalid value for scoreAggregationType: %s. Acceptable values: %s", setting.value, strings.Join(validScoreAggregationTypes, ", "))
+		}
+	case "stickinessBonusPercentage":
+		if setting.value.(int) > 100 {
+			return fmt.Errorf("Invalid value for stickinessBonusPercentage: %d. Value must be between 0 and 100", setting.value)
+		}
+	case "backupIp":
+		if setting.value.(string) != "" && net.ParseIP(setting.value.(string)) == nil {
+			return fmt.Errorf("Invalid value for backupIp: %s. Value must be an IPv4 or IPv6 address", setting.value)
+		}
+	}
+	return nil
+}
+
+// applyPropertySettings applies parsed settings to the property. Returns true if the property was modified.
+func applyPropertySettings(property *configgtm.Property, settings []*propertySetting) bool {
+
+	changes_made := false
+	propVal := reflect.ValueOf(property).Elem()
+	for _, setting := range settings {
+		field := propVal.FieldByName(setting.field)
+		newVal := reflect.ValueOf(setting.value)
+		if field.Interface() != newVal.Interface() {
+			field.Set(newVal)
+			changes_made = true
+		}
+	}
+	return changes_made
+}
+
+// dedupServers removes duplicate server entries retaining order
+func dedupServers(servers []string) []string {
+
+	var deduped []string
+	seen := make(map[string]bool)
+	for _, server := range servers {
+		server = strings.TrimSpace(server)
+		if server == "" || seen[server] {
+			continue
+		}
+		seen[server] = true
+		deduped = append(deduped, server)
+	}
+	return deduped
+}
+
+// validateServerAddresses checks servers are IP addresses of the family required by the property ipv6 setting
+func validateServerAddresses(servers []string, ipv6 bool) error {
+
+	for _, server := range servers {
+		ip := net.ParseIP(server)
+		if ip == nil {
+			return fmt.Errorf("Invalid server %s. Server must be an IP address", server)
+		}
+		if ipv6 && ip.To4() != nil {
+			return fmt.Errorf("Invalid server %s. Property is ipv6 and requires IPv6 server addresses", server)
+		}
+		if !ipv6 && ip.To4() == nil {
+			return fmt.Errorf("Invalid server %s. Property is not ipv6 and requires IPv4 server addresses", server)
+		}
+	}
+	return nil
+}
+
+// editServerList adds and removes servers from a target server list. Returns the new list and true if changed.
+func editServerList(current []string, add []string, remove []string) ([]string, bool) {
+
+	changed := false
+	var servers []string
+	for _, server := range current {
+		removed := false
+		for _, r := range remove {
+			if net.ParseIP(server).Equal(net.ParseIP(r)) || server == r {
+				removed = true
+				break
+			}
+		}
+		if removed {
+			changed = true
+			continue
+		}
+		servers = append(servers, server)
+	}
+	for _, a := range add {
+		exists := false
+		for _, server := range servers {
+			if net.ParseIP(server).Equal(net.ParseIP(a)) || server == a {
+				exists = true
+				break
+			}
+		}
+		if !exists {
+			servers = append(servers, a)
+			changed = true
+		}
+	}
+	return dedupServers(servers), changed
+}
+
+// missingServers returns servers not present in any of the specified datacenter targets
+func missingServers(property *configgtm.Property, dcIDs []int, servers []string) []string {
+
+	var notFound []string
+	for _, s := range servers {
+		found := false
+		for _, traffTarg := range property.TrafficTargets {
+			for _, dcID := range dcIDs {
+				if traffTarg.DatacenterId != dcID {
+					continue
+				}
+				for _, server := range traffTarg.Servers {
+					if net.ParseIP(server).Equal(net.ParseIP(s)) || server == s {
+						found = true
+					}
+				}
+			}
+		}
+		if !found {
+			notFound = append(notFound, s)
+		}
+	}
+	return notFound
+}
+
+// validateTargetServers checks enabled targets of the specified datacenters retain at least one server
+func validateTargetServers(property *configgtm.Property, dcIDs []int) error {
+
+	for _, traffTarg := range property.TrafficTargets {
+		for _, dcID := range dcIDs {
+			if traffTarg.DatacenterId == dcID && traffTarg.Enabled && len(traffTarg.Servers) == 0 {
+				return fmt.Errorf("Change would leave enabled datacenter %d target in property %s with no servers", dcID, property.Name)
+			}
+		}
+	}
+	return nil
+}
+
+// validateTargetAddresses checks server addresses of the targets of the specified datacenters against the property ipv6 setting
+func validateTargetAddresses(property *configgtm.Property, dcIDs map[int]bool) error {
+
+	for _, traffTarg := range property.TrafficTargets {
+		if !dcIDs[traffTarg.DatacenterId] {
+			continue
+		}
+		if err := validateServerAddresses(traffTarg.Servers, property.Ipv6); err != nil {
+			return fmt.Errorf("Datacenter %d target in property %s: %s", traffTarg.DatacenterId, property.Name, err.Error())
+		}
+	}
+	return nil
+}
+
+// selectLivenessTests returns the property liveness tests matching the exact names, patterns or all tests.
+// Returns an error listing available test names if a name or pattern does not match any test.
+func selectLivenessTests(property *configgtm.Property, names []string, patterns []*regexp.Regexp, all bool) ([]*configgtm.LivenessTest, error) {
+
+	var selected []*configgtm.LivenessTest
+	var available []string
+	for _, test := range property.LivenessTests {
+		available = append(available, test.Name)
+	}
+	if len(available) == 0 {
+		return nil, fmt.Errorf("Property %s has no liveness tests", property.Name)
+	}
+
+	var unmatched []string
+	for _, name := range names {
+		found := false
+		for _, test := range property.LivenessTests {
+			if test.Name == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			unmatched = append(unmatched, name)
+		}
+	}
+	for _, re := range patterns {
+		found := false
+		for _, test := range property.LivenessTests {
+			if re.MatchString(test.Name) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			unmatched = append(unmatched, re.String())
+		}
+	}
+	if len(unmatched) > 0 {
+		return nil, fmt.Errorf("Liveness test(s) not found in property %s: %s. Available liveness tests: %s", property.Name, strings.Join(unmatched, ", "), strings.Join(available, ", "))
+	}
+
+	for _, test := range property.LivenessTests {
+		match := all
+		for _, name := range names {
+			if test.Name == name {
+				match = true
+			}
+		}
+		for _, re := range patterns {
+			if re.MatchString(test.Name) {
+				match = true
+			}
+		}
+		if match {
+			selected = append(selected, test)
+		}
+	}
+
+	return selected, nil
+}
+
 // Pretty print output
 func renderStatus(status *configgtm.ResponseStatus, c *cli.Context) string {
 
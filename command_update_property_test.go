@@ -0,0 +1,235 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestSelectLivenessTests(t *testing.T) {
+
+	property := &configgtm.Property{Name: "www", LivenessTests: []*configgtm.LivenessTest{{Name: "http"}, {Name: "https"}, {Name: "tcp-443"}}}
+	tests := []struct {
+		name     string
+		property *configgtm.Property
+		names    []string
+		patterns []string
+		all      bool
+		want     []string
+		errText  string
+	}{
+		{"exact name", property, []string{"http"}, nil, false, []string{"http"}, ""},
+		{"exact names", property, []string{"https", "tcp-443"}, nil, false, []string{"https", "tcp-443"}, ""},
+		{"regex", property, nil, []string{"^http"}, false, []string{"http", "https"}, ""},
+		{"name and regex", property, []string{"tcp-443"}, []string{"s$"}, false, []string{"https", "tcp-443"}, ""},
+		{"all", property, nil, nil, true, []string{"http", "https", "tcp-443"}, ""},
+		{"name not found", property, []string{"htt"}, nil, false, nil, "Liveness test(s) not found in property www: htt. Available liveness tests: http, https, tcp-443"},
+		{"regex no match", property, nil, []string{"^udp"}, false, nil, "not found in property www: ^udp"},
+		{"no tests", &configgtm.Property{Name: "empty"}, nil, nil, true, nil, "Property empty has no liveness tests"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var patterns []*regexp.Regexp
+			for _, p := range tt.patterns {
+				patterns = append(patterns, regexp.MustCompile(p))
+			}
+			selected, err := selectLivenessTests(tt.property, tt.names, patterns, tt.all)
+			if tt.errText != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errText) {
+					t.Fatalf("error = %v, want %q", err, tt.errText)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, test := range selected {
+				got = append(got, test.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("selected %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePropertySettings(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		settings []string
+		want     map[string]interface{}
+		errText  string
+	}{
+		{"int", []string{"handoutLimit=8"}, map[string]interface{}{"HandoutLimit": 8}, ""},
+		{"bool", []string{"ipv6=true"}, map[string]interface{}{"Ipv6": true}, ""},
+		{"string trimmed", []string{" handoutMode = one-ip "}, map[string]interface{}{"HandoutMode": "one-ip"}, ""},
+		{"several", []string{"dynamicTTL=60", "backupCName=backup.example.com"}, map[string]interface{}{"DynamicTTL": 60, "BackupCName": "backup.example.com"}, ""},
+		{"empty backup ip", []string{"backupIp="}, map[string]interface{}{"BackupIp": ""}, ""},
+		{"unknown key", []string{"color=blue"}, nil, "Unsupported property setting color"},
+		{"not updatable", []string{"name=www2"}, nil, "Unsupported property setting name"},
+		{"missing value", []string{"handoutLimit"}, nil, "Settings must be specified as key=value"},
+		{"missing key", []string{"=8"}, nil, "Settings must be specified as key=value"},
+		{"bad int", []string{"handoutLimit=eight"}, nil, "Value must be an integer"},
+		{"negative int", []string{"failoverDelay=-1"}, nil, "Value must not be negative"},
+		{"bad bool", []string{"ipv6=maybe"}, nil, "Invalid value for ipv6: maybe"},
+		{"duplicate", []string{"staticTTL=60", "staticTTL=30"}, nil, "specified more than once"},
+		{"bad handout mode", []string{"handoutMode=random"}, nil, "Acceptable values: normal"},
+		{"bad aggregation", []string{"scoreAggregationType=sum"}, nil, "Acceptable values: mean"},
+		{"bonus above 100", []string{"stickinessBonusPercentage=101"}, nil, "between 0 and 100"},
+		{"bad backup ip", []string{"backupIp=backup.example.com"}, nil, "must be an IPv4 or IPv6 address"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			parsed, err := parsePropertySettings(tt.settings)
+			if tt.errText != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errText) {
+					t.Fatalf("error = %v, want %q", err, tt.errText)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := make(map[string]interface{})
+			for _, setting := range parsed {
+				got[setting.field] = setting.value
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parsed %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDedupServers(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		servers []string
+		want    []string
+	}{
+		{"none", nil, nil},
+		{"unique", []string{"192.0.2.1", "192.0.2.2"}, []string{"192.0.2.1", "192.0.2.2"}},
+		{"duplicates keep order", []string{"192.0.2.2", "192.0.2.1", "192.0.2.2"}, []string{"192.0.2.2", "192.0.2.1"}},
+		{"trim and drop empty", []string{" 192.0.2.1 ", "", "192.0.2.1"}, []string{"192.0.2.1"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dedupServers(tt.servers); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("dedupServers() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateServerAddresses(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		servers []string
+		ipv6    bool
+		errText string
+	}{
+		{"ipv4", []string{"192.0.2.1", "192.0.2.2"}, false, ""},
+		{"ipv6", []string{"2001:db8::1"}, true, ""},
+		{"none", nil, true, ""},
+		{"hostname", []string{"origin.example.com"}, false, "Server must be an IP address"},
+		{"ipv4 on ipv6 property", []string{"2001:db8::1", "192.0.2.1"}, true, "Invalid server 192.0.2.1. Property is ipv6"},
+		{"ipv6 on ipv4 property", []string{"2001:db8::1"}, false, "Property is not ipv6"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateServerAddresses(tt.servers, tt.ipv6)
+			if tt.errText == "" {
+				if err != nil {
+					t.Fatalf("unexpected error %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.errText) {
+				t.Fatalf("error = %v, want %q", err, tt.errText)
+			}
+		})
+	}
+}
+
+func TestEditServerList(t *testing.T) {
+
+	tests := []struct {
+		name    string
+		current []string
+		add     []string
+		remove  []string
+		want    []string
+		changed bool
+	}{
+		{"add", []string{"192.0.2.1"}, []string{"192.0.2.2"}, nil, []string{"192.0.2.1", "192.0.2.2"}, true},
+		{"add existing", []string{"192.0.2.1"}, []string{"192.0.2.1"}, nil, []string{"192.0.2.1"}, false},
+		{"add equivalent ipv6", []string{"2001:db8::1"}, []string{"2001:0db8:0:0:0:0:0:1"}, nil, []string{"2001:db8::1"}, false},
+		{"remove", []string{"192.0.2.1", "192.0.2.2"}, nil, []string{"192.0.2.1"}, []string{"192.0.2.2"}, true},
+		{"remove missing", []string{"192.0.2.1"}, nil, []string{"192.0.2.9"}, []string{"192.0.2.1"}, false},
+		{"remove last", []string{"192.0.2.1"}, nil, []string{"192.0.2.1"}, nil, true},
+		{"add and remove", []string{"192.0.2.1", "192.0.2.2"}, []string{"192.0.2.3"}, []string{"192.0.2.1"}, []string{"192.0.2.2", "192.0.2.3"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, changed := editServerList(tt.current, tt.add, tt.remove)
+			if !reflect.DeepEqual(got, tt.want) || changed != tt.changed {
+				t.Errorf("editServerList() = %v, %v, want %v, %v", got, changed, tt.want, tt.changed)
+			}
+		})
+	}
+}
+
+func TestValidateTargetAddresses(t *testing.T) {
+
+	newProperty := func(ipv6 bool) *configgtm.Property {
+		return &configgtm.Property{Name: "www", Ipv6: ipv6, TrafficTargets: []*configgtm.TrafficTarget{
+			{DatacenterId: 3131, Servers: []string{"192.0.2.1"}},
+			{DatacenterId: 3132, Servers: []string{"2001:db8::1"}},
+		}}
+	}
+	tests := []struct {
+		name    string
+		ipv6    bool
+		edited  map[int]bool
+		errText string
+	}{
+		{"unedited targets ignored", false, map[int]bool{}, ""},
+		{"edited ipv4 target", false, map[int]bool{3131: true}, ""},
+		{"edited ipv6 target on ipv4 property", false, map[int]bool{3132: true}, "Datacenter 3132 target in property www"},
+		{"ipv6 flip checks all targets", true, map[int]bool{3131: true, 3132: true}, "Datacenter 3131 target in property www: Invalid server 192.0.2.1"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateTargetAddresses(newProperty(tt.ipv6), tt.edited)
+			if tt.errText == "" {
+				if err != nil {
+					t.Fatalf("unexpected error %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.errText) {
+				t.Fatalf("error = %v, want %q", err, tt.errText)
+			}
+		})
+	}
+}
@@ -15,8 +15,16 @@
 package main
 
 import (
+	"encoding/json"
+	"fmt"
 	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	akamai "github.com/akamai/cli-common-golang"
+	"github.com/fatih/color"
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // SuccUpdateShort is the success status structure for no verbose status updates
@@ -45,23 +53,197 @@ type UpdateSummary struct {
 
 var verboseStatus bool = false
 
-// ParseNicknames parses any nicknames provided and adds to dcFlags
-func ParseNicknames(nicknames []string, domain string) error {
+var succShortArray []*SuccUpdateShort
+var succVerboseArray []*SuccUpdateVerbose
+var failedArray []*FailUpdate
+var dryrunArray []string
 
-	if len(nicknames) > 0 {
-		// get list of data centers
-		dcList, err := configgtm.ListDatacenters(domain)
+// recordPropertyUpdate submits a changed property (or captures it for dryrun) and records the result
+func recordPropertyUpdate(c *cli.Context, domainName string, prop *configgtm.Property, dryrun bool) {
+
+	if dryrun {
+		json, err := json.MarshalIndent(prop, "", "  ")
 		if err != nil {
-			return err
+			propError := &FailUpdate{PropName: prop.Name, FailMsg: err.Error()}
+			failedArray = append(failedArray, propError)
+		} else {
+			dryrunArray = append(dryrunArray, string(json))
+		}
+		return
+	}
+
+	stat, err := prop.Update(domainName)
+	if err != nil {
+		propError := &FailUpdate{PropName: prop.Name, FailMsg: err.Error()}
+		failedArray = append(failedArray, propError)
+	} else {
+		invalidateDomainCache(c, domainName)
+		if c.IsSet("verbose") && verboseStatus {
+			verbStat := &SuccUpdateVerbose{PropName: prop.Name, RespStat: stat}
+			succVerboseArray = append(succVerboseArray, verbStat)
+		} else {
+			shortStat := &SuccUpdateShort{PropName: prop.Name, ChangeId: stat.ChangeId}
+			succShortArray = append(succShortArray, shortStat)
 		}
-		// walk thru datacenters and nicknames
-		for _, dc := range dcList {
-			for _, nn := range nicknames {
-				if dc.Nickname == nn {
-					dcFlags.Set(strconv.Itoa(dc.DatacenterId))
-				}
+	}
+}
+
+// waitForDomainCompletion polls domain status until the change propagates, is denied or timeout (seconds) elapses
+func waitForDomainCompletion(c *cli.Context, domainName string, timeout int) {
+
+	var sleepInterval time.Duration = 1 // seconds. TODO:Should be configurable by user ...
+	var sleepTimeout time.Duration = 1  // seconds. TODO: Should be configurable by user ...
+	sleepInterval *= time.Duration(defaultInterval)
+	sleepTimeout *= time.Duration(timeout)
+	if !c.IsSet("json") {
+		akamai.StartSpinner("Waiting for completion ", "")
+	}
+	for {
+		dStat, err := configgtm.GetDomainStatus(domainName)
+		if err != nil {
+			if !c.IsSet("json") {
+				akamai.StopSpinner(" [Unable to retrieve domain status.]", true)
+			}
+			break
+		}
+		time.Sleep(sleepInterval * time.Second)
+		sleepTimeout -= sleepInterval
+		if dStat.PropagationStatus == "COMPLETE" {
+			if !c.IsSet("json") {
+				akamai.StopSpinner(" [Change deployed]", true)
+			}
+			break
+		} else if dStat.PropagationStatus == "DENIED" {
+			if !c.IsSet("json") {
+				akamai.StopSpinner(" [Change denied]", true)
 			}
+			break
 		}
+		if sleepTimeout <= 0 {
+			if !c.IsSet("json") {
+				akamai.StopSpinner(" [Maximum wait time elapsed. Use query-status confirm successful deployment]", true)
+			}
+			break
+		}
+	}
+}
+
+// renderUpdateSummary outputs the collected multi property update results
+func renderUpdateSummary(c *cli.Context, title string, propCount int, dryrun bool) error {
+
+	if propCount == 1 && len(failedArray) > 0 {
+		return cli.NewExitError(color.RedString(fmt.Sprintf("Error updating property %s: %s", failedArray[0].PropName, failedArray[0].FailMsg)), 1)
+	}
+
+	updateSum := UpdateSummary{}
+	if dryrun {
+		updateSum.Updated_Properties = dryrunArray
+		updateSum.Failed_Updates = failedArray
+		json, err := json.MarshalIndent(updateSum, "", "  ")
+		if err != nil {
+			return cli.NewExitError(color.RedString("Unable to display dryrun results"), 1)
+		}
+		fmt.Fprintln(c.App.Writer, string(json))
+		return nil
+	}
+
+	if c.IsSet("verbose") && verboseStatus && len(succVerboseArray) > 0 {
+		updateSum.Updated_Properties = succVerboseArray
+	} else if len(succShortArray) > 0 {
+		updateSum.Updated_Properties = succShortArray
 	}
+	if len(failedArray) > 0 {
+		updateSum.Failed_Updates = failedArray
+	}
+
+	if updateSum.Failed_Updates == nil && updateSum.Updated_Properties == nil {
+		if !c.IsSet("json") {
+			fmt.Fprintln(c.App.Writer, "No property updates were needed.")
+		}
+	} else {
+		if c.IsSet("json") && c.Bool("json") {
+			json, err := json.MarshalIndent(updateSum, "", "  ")
+			if err != nil {
+				return cli.NewExitError(color.RedString("Unable to display status results"), 1)
+			}
+			fmt.Fprintln(c.App.Writer, string(json))
+		} else {
+			fmt.Fprintln(c.App.Writer, "")
+			fmt.Fprintln(c.App.Writer, renderSummaryTable(title, c))
+		}
+	}
+
 	return nil
+
+}
+
+// Pretty print update summary
+func renderSummaryTable(title string, c *cli.Context) string {
+
+	var outString string
+	outString += fmt.Sprintln(" ")
+	outString += fmt.Sprintln(title)
+	outString += fmt.Sprintln(" ")
+	tableString := &strings.Builder{}
+	table := tablewriter.NewWriter(tableString)
+
+	table.SetReflowDuringAutoWrap(false)
+	table.SetCenterSeparator(" ")
+	table.SetColumnSeparator(" ")
+	table.SetRowSeparator(" ")
+	table.SetBorder(false)
+	table.SetAutoWrapText(false)
+	table.SetColumnAlignment([]int{tablewriter.ALIGN_LEFT, tablewriter.ALIGN_LEFT, tablewriter.ALIGN_LEFT, tablewriter.ALIGN_LEFT})
+	table.SetAlignment(tablewriter.ALIGN_CENTER)
+
+	// Build summary table. Exclude Links in status.
+	rowData := []string{"Completed Updates", " ", " ", " "}
+	table.Append(rowData)
+	if c.IsSet("verbose") && verboseStatus {
+		if len(succVerboseArray) == 0 {
+			rowData := []string{" ", "No successful updates", " ", " "}
+			table.Append(rowData)
+		} else {
+			for _, prop := range succVerboseArray {
+				rowData := []string{" ", prop.PropName, "ChangeId", prop.RespStat.ChangeId}
+				table.Append(rowData)
+				rowData = []string{" ", " ", "Message", prop.RespStat.Message}
+				table.Append(rowData)
+				rowData = []string{" ", " ", "Passing Validation", strconv.FormatBool(prop.RespStat.PassingValidation)}
+				table.Append(rowData)
+				rowData = []string{" ", " ", "Propagation Status", prop.RespStat.PropagationStatus}
+				table.Append(rowData)
+				rowData = []string{" ", " ", "Propagation Status Date", prop.RespStat.PropagationStatusDate}
+				table.Append(rowData)
+			}
+		}
+	} else {
+		if len(succShortArray) == 0 {
+			rowData := []string{" ", "No successful updates", " ", " "}
+			table.Append(rowData)
+		} else {
+			for _, prop := range succShortArray {
+				rowData := []string{" ", prop.PropName, "ChangeId", prop.ChangeId}
+				table.Append(rowData)
+			}
+		}
+	}
+
+	rowData = []string{"Failed Updates", " ", " ", " "}
+	table.Append(rowData)
+	if len(failedArray) == 0 {
+		rowData := []string{" ", "No failed property updates", " ", " "}
+		table.Append(rowData)
+	} else {
+		for _, prop := range failedArray {
+			rowData := []string{" ", prop.PropName, "Failure Message", prop.FailMsg}
+			table.Append(rowData)
+		}
+	}
+
+	table.Render()
+	outString += fmt.Sprintln(tableString.String())
+
+	return outString
+
 }
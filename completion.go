@@ -0,0 +1,280 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	akamai "github.com/akamai/cli-common-golang"
+	"github.com/fatih/color"
+	"github.com/urfave/cli"
+	"os"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// completionFlagValues maps flags to the kind of value they accept
+var completionFlagValues = map[string]string{
+	"datacenter":          "datacenter",
+	"property":            "property",
+	"liveness_test":       "liveness-test",
+	"liveness-test-regex": "liveness-test",
+	"name":                "liveness-test",
+}
+
+var argsUsageWords = regexp.MustCompile(`[a-z]+`)
+
+// completionState describes the command line being completed
+type completionState struct {
+	positional []string
+	flagValues map[string]string
+	valueFlag  string
+}
+
+// parseCompletionArgs walks the command line following the command name, collecting positional
+// arguments, flag values and the flag, if any, whose value is being completed
+func parseCompletionArgs(c *cli.Context, args []string) *completionState {
+
+	state := &completionState{flagValues: make(map[string]string)}
+	start := -1
+	for i, arg := range args {
+		if arg == c.Command.Name {
+			start = i + 1
+			break
+		}
+	}
+	if start < 0 {
+		return state
+	}
+	valueFlags := make(map[string]bool)
+	for _, flag := range c.Command.Flags {
+		if !flagTakesValue(flag) {
+			continue
+		}
+		for _, name := range strings.Split(flag.GetName(), ",") {
+			valueFlags[strings.TrimSpace(name)] = true
+		}
+	}
+	for i := start; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--"+cli.BashCompletionFlag.GetName() {
+			continue
+		}
+		if !strings.HasPrefix(arg, "-") {
+			state.positional = append(state.positional, arg)
+			continue
+		}
+		name := strings.TrimLeft(arg, "-")
+		if strings.Contains(name, "=") {
+			kv := strings.SplitN(name, "=", 2)
+			state.flagValues[kv[0]] = kv[1]
+			continue
+		}
+		if !valueFlags[name] {
+			continue
+		}
+		if i+1 < len(args) && args[i+1] != "--"+cli.BashCompletionFlag.GetName() {
+			state.flagValues[name] = args[i+1]
+			i++
+			continue
+		}
+		state.valueFlag = name
+	}
+	return state
+}
+
+// flagTakesValue returns true if the flag requires a value. Generic flags may be boolean, e.g. watch.
+func flagTakesValue(flag cli.Flag) bool {
+
+	switch flag := flag.(type) {
+	case cli.BoolFlag, cli.BoolTFlag:
+		return false
+	case cli.GenericFlag:
+		if boolFlag, ok := flag.Value.(interface{ IsBoolFlag() bool }); ok {
+			return !boolFlag.IsBoolFlag()
+		}
+	}
+	return true
+}
+
+// positionalKinds returns the kinds of positional arguments described by the command ArgsUsage
+func positionalKinds(c *cli.Context) []string {
+
+	return argsUsageWords.FindAllString(c.Command.ArgsUsage, -1)
+}
+
+// cmdAutoComplete completes domain, property, datacenter and liveness test names. Values are read from the local cache.
+func cmdAutoComplete(c *cli.Context) {
+
+	state := parseCompletionArgs(c, os.Args)
+	kinds := positionalKinds(c)
+	kind := ""
+	if state.valueFlag != "" {
+		kind = completionFlagValues[state.valueFlag]
+	} else if len(state.positional) < len(kinds) {
+		kind = kinds[len(state.positional)]
+	}
+	if kind != "domain" && kind != "property" && kind != "datacenter" && kind != "liveness-test" {
+		if state.valueFlag == "" {
+			akamai.DefaultAutoComplete(c)
+		}
+		return
+	}
+
+	config, err := akamai.GetEdgegridConfig(c)
+	if err != nil {
+		return
+	}
+	configgtm.Init(config)
+
+	if kind == "domain" {
+		names, err := cachedDomainNames(c)
+		if err != nil {
+			return
+		}
+		printCompletions(c, names)
+		return
+	}
+
+	var domainName, propName string
+	for i, k := range kinds {
+		if i >= len(state.positional) {
+			break
+		}
+		switch k {
+		case "domain":
+			domainName = state.positional[i]
+		case "property":
+			propName = state.positional[i]
+		}
+	}
+	if propName == "" {
+		propName = state.flagValues["property"]
+	}
+	if domainName == "" {
+		return
+	}
+	dom, err := cachedDomain(c, domainName)
+	if err != nil {
+		return
+	}
+	printCompletions(c, domainCompletions(dom, kind, propName))
+}
+
+// domainCompletions returns the names of domain objects of kind. Liveness tests are limited to property if specified.
+func domainCompletions(dom *configgtm.Domain, kind string, propName string) []string {
+
+	var names []string
+	switch kind {
+	case "property":
+		for _, prop := range dom.Properties {
+			names = append(names, prop.Name)
+		}
+	case "datacenter":
+		for _, dc := range dom.Datacenters {
+			if dc.Nickname != "" {
+				names = append(names, dc.Nickname)
+			}
+			names = append(names, strconv.Itoa(dc.DatacenterId))
+		}
+		if cliConfig, err := loadCLIConfig(); err == nil {
+			for group := range cliConfig.DatacenterGroups {
+				names = append(names, "@"+group)
+			}
+		}
+	case "liveness-test":
+		for _, prop := range dom.Properties {
+			if propName != "" && prop.Name != propName {
+				continue
+			}
+			for _, test := range prop.LivenessTests {
+				names = append(names, test.Name)
+			}
+		}
+	}
+	return names
+}
+
+// printCompletions writes the unique, sorted completion values
+func printCompletions(c *cli.Context, names []string) {
+
+	seen := make(map[string]bool)
+	var unique []string
+	for _, name := range names {
+		if !seen[name] {
+			seen[name] = true
+			unique = append(unique, name)
+		}
+	}
+	sort.Strings(unique)
+	for _, name := range unique {
+		fmt.Fprintln(c.App.Writer, name)
+	}
+}
+
+const bashCompletionScript = `# bash completion for akamai-gtm
+_akamai_gtm_complete() {
+    local cur="${COMP_WORDS[COMP_CWORD]}"
+    local IFS=$'\n'
+    COMPREPLY=( $(compgen -W "$("${COMP_WORDS[@]:0:$COMP_CWORD}" --generate-auto-complete 2>/dev/null)" -- "$cur") )
+}
+complete -F _akamai_gtm_complete akamai-gtm
+`
+
+const zshCompletionScript = `#compdef akamai-gtm
+# zsh completion for akamai-gtm
+_akamai_gtm() {
+    local -a opts
+    opts=("${(@f)$(${words[1,CURRENT-1]} --generate-auto-complete 2>/dev/null)}")
+    compadd -a opts
+}
+compdef _akamai_gtm akamai-gtm
+`
+
+const fishCompletionScript = `# fish completion for akamai-gtm
+function __akamai_gtm_complete
+    set -l tokens (commandline -opc)
+    $tokens --generate-auto-complete 2>/dev/null
+end
+complete -c akamai-gtm -f -a '(__akamai_gtm_complete)'
+`
+
+// completeShells completes the shells supported by completion
+func completeShells(c *cli.Context) {
+
+	printCompletions(c, []string{"bash", "zsh", "fish"})
+}
+
+// worker function for completion
+func cmdCompletion(c *cli.Context) error {
+
+	if c.NArg() != 1 {
+		cli.ShowCommandHelp(c, c.Command.Name)
+		return cli.NewExitError(color.RedString("shell is required"), 1)
+	}
+	switch c.Args().First() {
+	case "bash":
+		fmt.Fprint(c.App.Writer, bashCompletionScript)
+	case "zsh":
+		fmt.Fprint(c.App.Writer, zshCompletionScript)
+	case "fish":
+		fmt.Fprint(c.App.Writer, fishCompletionScript)
+	default:
+		return cli.NewExitError(color.RedString(fmt.Sprintf("Unsupported shell %s. Supported shells are bash, zsh and fish", c.Args().First())), 1)
+	}
+	return nil
+}
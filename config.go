@@ -0,0 +1,65 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+// CLIConfig represents the CLI configuration file
+type CLIConfig struct {
+	DatacenterGroups map[string][]string `json:"datacenterGroups"`
+	CacheTTL         int                 `json:"cacheTTL"`
+}
+
+// cliConfigDir returns the directory holding CLI configuration and state
+func cliConfigDir() string {
+
+	dir, err := os.UserHomeDir()
+	if err != nil {
+		dir = "."
+	}
+	return filepath.Join(dir, ".akamai-gtm")
+}
+
+// cliConfigFile returns the location of the CLI configuration file. May be overridden by AKAMAI_GTM_CONFIG.
+func cliConfigFile() string {
+
+	if configFile := os.Getenv("AKAMAI_GTM_CONFIG"); configFile != "" {
+		return configFile
+	}
+	return filepath.Join(cliConfigDir(), "config.json")
+}
+
+// loadCLIConfig reads the CLI configuration file. A missing file is an empty configuration.
+func loadCLIConfig() (*CLIConfig, error) {
+
+	cliConfig := &CLIConfig{}
+	data, err := ioutil.ReadFile(cliConfigFile())
+	if os.IsNotExist(err) {
+		return cliConfig, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read config file %s. %s", cliConfigFile(), err.Error())
+	}
+	if err := json.Unmarshal(data, cliConfig); err != nil {
+		return nil, fmt.Errorf("Unable to parse config file %s. %s", cliConfigFile(), err.Error())
+	}
+	return cliConfig, nil
+}
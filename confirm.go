@@ -0,0 +1,151 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"github.com/fatih/color"
+	"github.com/mattn/go-isatty"
+	"github.com/olekukonko/tablewriter"
+	"github.com/urfave/cli"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// PlannedTargetChange represents a traffic target change within a change plan
+type PlannedTargetChange struct {
+	PropName     string
+	DatacenterId int
+	Change       string
+}
+
+// targetChanges lists the traffic target enable, disable, weight and add changes of the plan
+func (plan *changePlan) targetChanges() []*PlannedTargetChange {
+
+	var changes []*PlannedTargetChange
+	for _, prop := range plan.properties {
+		enabledBefore := plan.enabledBefore[prop.Name]
+		weightsBefore := plan.weightsBefore[prop.Name]
+		for _, tt := range prop.TrafficTargets {
+			wasEnabled, existed := enabledBefore[tt.DatacenterId]
+			if !existed {
+				changes = append(changes, &PlannedTargetChange{PropName: prop.Name, DatacenterId: tt.DatacenterId, Change: "added"})
+				continue
+			}
+			if tt.Enabled != wasEnabled {
+				change := "disabled"
+				if tt.Enabled {
+					change = "enabled"
+				}
+				changes = append(changes, &PlannedTargetChange{PropName: prop.Name, DatacenterId: tt.DatacenterId, Change: change})
+			}
+			if tt.Weight != weightsBefore[tt.DatacenterId] {
+				change := fmt.Sprintf("weight %s -> %s", strconv.FormatFloat(weightsBefore[tt.DatacenterId], 'f', -1, 64), strconv.FormatFloat(tt.Weight, 'f', -1, 64))
+				changes = append(changes, &PlannedTargetChange{PropName: prop.Name, DatacenterId: tt.DatacenterId, Change: change})
+			}
+		}
+	}
+	return changes
+}
+
+// stdinIsTerminal returns true if input may be read from a user
+func stdinIsTerminal() bool {
+
+	return isatty.IsTerminal(os.Stdin.Fd()) || isatty.IsCygwinTerminal(os.Stdin.Fd())
+}
+
+// confirmPlan displays the planned changes and requires the user to confirm them.
+// Dryrun plans and --yes skip confirmation. Confirmation is refused if stdin is not a terminal.
+func confirmPlan(c *cli.Context, plan *changePlan) error {
+
+	if plan.dryrun || len(plan.properties) == 0 || c.Bool("yes") {
+		return nil
+	}
+	return promptConfirmation(plan, os.Stdin, os.Stderr, stdinIsTerminal())
+}
+
+// promptConfirmation writes the plan summary and prompt to out and reads the confirmation from in.
+// terminal reports whether in may be read from a user.
+func promptConfirmation(plan *changePlan, in io.Reader, out io.Writer, terminal bool) error {
+
+	if !terminal {
+		return cli.NewExitError(color.RedString("Confirmation required but stdin is not a terminal. Use --yes to apply change(s) without confirmation"), 1)
+	}
+
+	fmt.Fprintln(out, renderPlanSummary(plan))
+	fmt.Fprint(out, fmt.Sprintf("Type 'yes' or the domain name to apply change(s) to %s: ", plan.domain))
+	answer, _ := bufio.NewReader(in).ReadString('\n')
+	answer = strings.TrimSpace(answer)
+	if !strings.EqualFold(answer, "yes") && !strings.EqualFold(answer, plan.domain) {
+		return cli.NewExitError(color.RedString("Change(s) cancelled"), 1)
+	}
+	return nil
+}
+
+// Pretty print change plan summary
+func renderPlanSummary(plan *changePlan) string {
+
+	changes := plan.targetChanges()
+	enabled, disabled, weights := 0, 0, 0
+	for _, change := range changes {
+		switch {
+		case change.Change == "enabled":
+			enabled++
+		case change.Change == "disabled":
+			disabled++
+		case strings.HasPrefix(change.Change, "weight"):
+			weights++
+		}
+	}
+
+	var outString string
+	outString += fmt.Sprintln(" ")
+	outString += fmt.Sprintln(fmt.Sprintf("Planned Changes in domain %s", plan.domain))
+	outString += fmt.Sprintln(" ")
+	outString += fmt.Sprintln(fmt.Sprintf("Properties affected: %d  Targets enabled: %d  Targets disabled: %d  Weights changed: %d", len(plan.properties), enabled, disabled, weights))
+	outString += fmt.Sprintln(" ")
+	tableString := &strings.Builder{}
+	table := tablewriter.NewWriter(tableString)
+
+	table.SetHeader([]string{"Property", "Datacenter", "Change"})
+	table.SetReflowDuringAutoWrap(false)
+	table.SetCenterSeparator(" ")
+	table.SetColumnSeparator(" ")
+	table.SetRowSeparator(" ")
+	table.SetBorder(false)
+	table.SetAutoWrapText(false)
+	table.SetColumnAlignment([]int{tablewriter.ALIGN_LEFT, tablewriter.ALIGN_CENTER, tablewriter.ALIGN_LEFT})
+	table.SetAlignment(tablewriter.ALIGN_CENTER)
+
+	for _, prop := range plan.properties {
+		targetChange := false
+		for _, change := range changes {
+			if change.PropName == prop.Name {
+				table.Append([]string{prop.Name, strconv.Itoa(change.DatacenterId), change.Change})
+				targetChange = true
+			}
+		}
+		if !targetChange {
+			table.Append([]string{prop.Name, " ", "property configuration updated"})
+		}
+	}
+	table.Render()
+	outString += fmt.Sprintln(tableString.String())
+
+	return outString
+}
@@ -0,0 +1,72 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+func TestPromptConfirmation(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		input    string
+		terminal bool
+		errText  string
+	}{
+		{"accept yes", "yes\n", true, ""},
+		{"accept domain", "Example.akadns.net\n", true, ""},
+		{"accept without newline", " YES ", true, ""},
+		{"refuse", "no\n", true, "Change(s) cancelled"},
+		{"refuse empty", "", true, "Change(s) cancelled"},
+		{"not a terminal", "yes\n", false, "stdin is not a terminal"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			err := promptConfirmation(newTestPlan("example.akadns.net", "www"), strings.NewReader(tt.input), &out, tt.terminal)
+			if tt.errText == "" {
+				if err != nil {
+					t.Fatalf("unexpected error %s", err)
+				}
+			} else if err == nil || !strings.Contains(err.Error(), tt.errText) {
+				t.Fatalf("error = %v, want %q", err, tt.errText)
+			}
+			if tt.terminal && !strings.Contains(out.String(), "apply change(s) to example.akadns.net") {
+				t.Errorf("prompt not written:\n%s", out.String())
+			}
+			if !tt.terminal && out.Len() > 0 {
+				t.Errorf("unexpected output without a terminal:\n%s", out.String())
+			}
+		})
+	}
+}
+
+func TestConfirmPlanSkipped(t *testing.T) {
+
+	plan := newTestPlan("example.akadns.net", "www")
+	if err := confirmPlan(newTestContext(t, "update-property", "--yes"), plan); err != nil {
+		t.Errorf("--yes: unexpected error %s", err)
+	}
+	plan.dryrun = true
+	if err := confirmPlan(newTestContext(t, "update-property"), plan); err != nil {
+		t.Errorf("dryrun: unexpected error %s", err)
+	}
+	if err := confirmPlan(newTestContext(t, "update-property"), newTestPlan("example.akadns.net")); err != nil {
+		t.Errorf("empty plan: unexpected error %s", err)
+	}
+}
@@ -0,0 +1,288 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	"github.com/urfave/cli"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const maxSuggestions int = 3
+
+// ResolveDatacenters validates the datacenter ids and resolves the nicknames specified in dcs against the domain.
+// Unknown ids or nicknames and ambiguous nicknames are errors. Resolved ids replace the ids in dcs.
+// The datacenter list is read from the local cache if useCache is true.
+func ResolveDatacenters(c *cli.Context, dcs *arrayFlags, domain string, useCache bool) error {
+
+	if len(dcs.flagList) == 0 && len(dcs.nicknamesList) == 0 && len(dcs.groupsList) == 0 {
+		return nil
+	}
+	var dcList []*configgtm.Datacenter
+	var err error
+	if useCache {
+		dcList, err = cachedDatacenters(c, domain)
+	} else {
+		dcList, err = configgtm.ListDatacenters(domain)
+	}
+	if err != nil {
+		if verboseStatus {
+			return errors.New("Unable to retrieve datacenter list. " + err.Error())
+		}
+		return errors.New("Unable to retrieve datacenter list.")
+	}
+
+	if err := resolveDatacenterList(dcs, dcList, domain); err != nil {
+		return err
+	}
+
+	if verboseStatus && !c.IsSet("json") && !c.Bool("csv") {
+		fmt.Println("Resolved datacenter(s):")
+		for _, id := range dcs.flagList {
+			fmt.Println(fmt.Sprintf("   %d  %s", id, findDatacenterById(dcList, id).Nickname))
+		}
+	}
+	return nil
+}
+
+// resolveDatacenterList resolves the ids, nicknames and groups specified in dcs against the domain datacenter list
+func resolveDatacenterList(dcs *arrayFlags, dcList []*configgtm.Datacenter, domain string) error {
+
+	// groups expand to ids, nicknames or datacenters
+	ids, nicknames, resolved, problems := expandDatacenterGroups(dcs.groupsList, dcList)
+	ids = append(ids, dcs.flagList...)
+	nicknames = append(nicknames, dcs.nicknamesList...)
+	for _, id := range ids {
+		dc := findDatacenterById(dcList, id)
+		if dc == nil {
+			problems = append(problems, fmt.Sprintf("datacenter id %d not found%s", id, suggestionText(datacenterSuggestions(dcList, strconv.Itoa(id)))))
+			continue
+		}
+		resolved = append(resolved, dc)
+	}
+	for _, nickname := range nicknames {
+		matches := findDatacentersByNickname(dcList, nickname)
+		switch len(matches) {
+		case 0:
+			problems = append(problems, fmt.Sprintf("datacenter %s not found%s", nickname, suggestionText(datacenterSuggestions(dcList, nickname))))
+		case 1:
+			resolved = append(resolved, matches[0])
+		default:
+			var ids []string
+			for _, dc := range matches {
+				ids = append(ids, strconv.Itoa(dc.DatacenterId))
+			}
+			problems = append(problems, fmt.Sprintf("datacenter nickname %s is ambiguous. Matches datacenter ids %s. Specify the datacenter by id", nickname, strings.Join(ids, ", ")))
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("Unable to resolve datacenter(s) in domain %s: %s", domain, strings.Join(problems, "; "))
+	}
+
+	dcs.flagList = []int{}
+	dcs.flagStringList = []string{}
+	for _, dc := range resolved {
+		dcs.Set(strconv.Itoa(dc.DatacenterId))
+	}
+	dcs.nicknamesList = []string{}
+	dcs.groupsList = []string{}
+	return nil
+}
+
+// findDatacenterById returns the datacenter with id or nil
+func findDatacenterById(dcList []*configgtm.Datacenter, id int) *configgtm.Datacenter {
+
+	for _, dc := range dcList {
+		if dc.DatacenterId == id {
+			return dc
+		}
+	}
+	return nil
+}
+
+// findDatacentersByNickname returns the datacenters matching nickname. An exact match is preferred over case-insensitive matches.
+func findDatacentersByNickname(dcList []*configgtm.Datacenter, nickname string) []*configgtm.Datacenter {
+
+	var exact, folded []*configgtm.Datacenter
+	for _, dc := range dcList {
+		if dc.Nickname == nickname {
+			exact = append(exact, dc)
+		} else if strings.EqualFold(dc.Nickname, nickname) {
+			folded = append(folded, dc)
+		}
+	}
+	if len(exact) == 1 {
+		return exact
+	}
+	return append(exact, folded...)
+}
+
+// datacenterSuggestions returns the datacenter nicknames and ids closest to value
+func datacenterSuggestions(dcList []*configgtm.Datacenter, value string) []string {
+
+	var names []string
+	for _, dc := range dcList {
+		names = append(names, dc.Nickname, strconv.Itoa(dc.DatacenterId))
+	}
+	return closestMatches(names, value)
+}
+
+// closestMatches returns the names closest to value
+func closestMatches(names []string, value string) []string {
+
+	type candidate struct {
+		name     string
+		distance int
+	}
+	var candidates []candidate
+	value = strings.ToLower(value)
+	threshold := len(value) / 3
+	if threshold < 2 {
+		threshold = 2
+	}
+	for _, name := range names {
+		if name == "" {
+			continue
+		}
+		distance := editDistance(value, strings.ToLower(name))
+		if distance <= threshold || strings.Contains(strings.ToLower(name), value) {
+			candidates = append(candidates, candidate{name: name, distance: distance})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool { return candidates[i].distance < candidates[j].distance })
+	var suggestions []string
+	seen := make(map[string]bool)
+	for _, cand := range candidates {
+		if len(suggestions) == maxSuggestions {
+			break
+		}
+		if seen[cand.name] {
+			continue
+		}
+		seen[cand.name] = true
+		suggestions = append(suggestions, cand.name)
+	}
+	return suggestions
+}
+
+// datacenterAttributes maps attribute selector names to datacenter attribute values
+var datacenterAttributes = map[string]func(dc *configgtm.Datacenter) string{
+	"continent": func(dc *configgtm.Datacenter) string { return dc.Continent },
+	"country":   func(dc *configgtm.Datacenter) string { return dc.Country },
+	"state":     func(dc *configgtm.Datacenter) string { return dc.StateOrProvince },
+	"city":      func(dc *configgtm.Datacenter) string { return dc.City },
+}
+
+// expandDatacenterGroups expands @group references from the CLI config file into datacenter ids and nicknames,
+// and attribute=value selectors into the matching datacenters
+func expandDatacenterGroups(groups []string, dcList []*configgtm.Datacenter) (ids []int, nicknames []string, dcs []*configgtm.Datacenter, problems []string) {
+
+	if len(groups) == 0 {
+		return
+	}
+	var cliConfig *CLIConfig
+	for _, group := range groups {
+		if strings.HasPrefix(group, "@") {
+			if cliConfig == nil {
+				var err error
+				if cliConfig, err = loadCLIConfig(); err != nil {
+					problems = append(problems, err.Error())
+					return
+				}
+			}
+			name := strings.TrimPrefix(group, "@")
+			members, ok := cliConfig.DatacenterGroups[name]
+			if !ok {
+				var names []string
+				for groupName := range cliConfig.DatacenterGroups {
+					names = append(names, "@"+groupName)
+				}
+				sort.Strings(names)
+				problems = append(problems, fmt.Sprintf("datacenter group %s not defined in %s%s", group, cliConfigFile(), suggestionText(closestMatches(names, group))))
+				continue
+			}
+			for _, member := range members {
+				if id, err := strconv.Atoi(member); err == nil {
+					ids = append(ids, id)
+				} else {
+					nicknames = append(nicknames, member)
+				}
+			}
+			continue
+		}
+		selector := strings.SplitN(group, "=", 2)
+		attr := strings.ToLower(strings.TrimSpace(selector[0]))
+		value := strings.TrimSpace(selector[1])
+		attrValue, ok := datacenterAttributes[attr]
+		if !ok {
+			problems = append(problems, fmt.Sprintf("unknown datacenter attribute %s. Valid attributes are continent, country, state and city", attr))
+			continue
+		}
+		matched := false
+		for _, dc := range dcList {
+			if strings.EqualFold(attrValue(dc), value) {
+				dcs = append(dcs, dc)
+				matched = true
+			}
+		}
+		if !matched {
+			problems = append(problems, fmt.Sprintf("no datacenters with %s=%s", attr, value))
+		}
+	}
+	return
+}
+
+// suggestionText formats suggestions for inclusion in an error message
+func suggestionText(suggestions []string) string {
+
+	if len(suggestions) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(". Did you mean %s?", strings.Join(suggestions, " or "))
+}
+
+// editDistance returns the Levenshtein distance between a and b
+func editDistance(a, b string) int {
+
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, min(curr[j-1]+1, prev[j-1]+cost))
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,151 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testDatacenterList returns datacenters with a duplicate nickname differing only in case
+func testDatacenterList() []*configgtm.Datacenter {
+
+	return []*configgtm.Datacenter{
+		{DatacenterId: 3131, Nickname: "Frankfurt", Continent: "EU", Country: "DE"},
+		{DatacenterId: 3132, Nickname: "Amsterdam", Continent: "EU", Country: "NL"},
+		{DatacenterId: 3133, Nickname: "Boston", Continent: "NA", Country: "US"},
+		{DatacenterId: 3134, Nickname: "boston", Continent: "NA", Country: "US"},
+	}
+}
+
+func TestResolveDatacenterList(t *testing.T) {
+
+	configFile := filepath.Join(t.TempDir(), "config.json")
+	if err := ioutil.WriteFile(configFile, []byte(`{"datacenterGroups": {"europe": ["3131", "amsterdam"]}}`), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("AKAMAI_GTM_CONFIG", configFile)
+
+	tests := []struct {
+		name    string
+		values  []string
+		want    []int
+		errText string
+	}{
+		{"id", []string{"3132"}, []int{3132}, ""},
+		{"unknown id", []string{"3135"}, nil, "datacenter id 3135 not found. Did you mean 3131 or 3132 or 3133?"},
+		{"nickname", []string{"Frankfurt"}, []int{3131}, ""},
+		{"case-insensitive nickname", []string{"FRANKFURT"}, []int{3131}, ""},
+		{"exact nickname preferred", []string{"boston"}, []int{3134}, ""},
+		{"ambiguous nickname", []string{"BOSTON"}, nil, "datacenter nickname BOSTON is ambiguous. Matches datacenter ids 3133, 3134"},
+		{"unknown nickname", []string{"Frankfort"}, nil, "datacenter Frankfort not found. Did you mean Frankfurt?"},
+		{"group", []string{"@europe"}, []int{3131, 3132}, ""},
+		{"group and id", []string{"@europe", "3133"}, []int{3131, 3133, 3132}, ""},
+		{"undefined group", []string{"@europa"}, nil, "datacenter group @europa not defined in " + configFile + ". Did you mean @europe?"},
+		{"continent selector", []string{"continent=eu"}, []int{3131, 3132}, ""},
+		{"continent selector with no matches", []string{"continent=AS"}, nil, "no datacenters with continent=AS"},
+		{"unknown attribute", []string{"region=EU"}, nil, "unknown datacenter attribute region"},
+		{"problems combined", []string{"3135", "Frankfort"}, nil, "not found. Did you mean 3131 or 3132 or 3133?; datacenter Frankfort"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dcs := &arrayFlags{}
+			for _, value := range tt.values {
+				dcs.Set(value)
+			}
+			err := resolveDatacenterList(dcs, testDatacenterList(), "example.akadns.net")
+			if tt.errText != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errText) {
+					t.Fatalf("error = %v, want %q", err, tt.errText)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error %s", err)
+			}
+			if !reflect.DeepEqual(dcs.flagList, tt.want) {
+				t.Errorf("resolved %v, want %v", dcs.flagList, tt.want)
+			}
+			if len(dcs.nicknamesList) > 0 || len(dcs.groupsList) > 0 {
+				t.Errorf("unresolved nicknames %v groups %v", dcs.nicknamesList, dcs.groupsList)
+			}
+		})
+	}
+}
+
+func TestDatacenterSuggestions(t *testing.T) {
+
+	tests := []struct {
+		value string
+		want  []string
+	}{
+		{"frankfort", []string{"Frankfurt"}},
+		{"BOSTN", []string{"Boston", "boston"}},
+		{"3135", []string{"3131", "3132", "3133"}},
+		{"sydney", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := datacenterSuggestions(testDatacenterList(), tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("datacenterSuggestions(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClosestMatches(t *testing.T) {
+
+	names := []string{"Frankfurt", "Amsterdam", "Boston", "boston", "Singapore", ""}
+	tests := []struct {
+		value string
+		want  []string
+	}{
+		{"frankfort", []string{"Frankfurt"}},
+		{"BOSTN", []string{"Boston", "boston"}},
+		{"ton", []string{"Boston", "boston"}},
+		{"sydney", nil},
+		{"a", []string{"Frankfurt", "Amsterdam", "Singapore"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			if got := closestMatches(names, tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("closestMatches(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestExpandDatacenterGroups(t *testing.T) {
+
+	t.Setenv("AKAMAI_GTM_CONFIG", filepath.Join(t.TempDir(), "missing.json"))
+	ids, nicknames, dcs, problems := expandDatacenterGroups([]string{"Country = de", "continent=NA", "@europe"}, testDatacenterList())
+	if len(ids) > 0 || len(nicknames) > 0 {
+		t.Errorf("unexpected ids %v nicknames %v", ids, nicknames)
+	}
+	var got []int
+	for _, dc := range dcs {
+		got = append(got, dc.DatacenterId)
+	}
+	if want := []int{3131, 3133, 3134}; !reflect.DeepEqual(got, want) {
+		t.Errorf("selected %v, want %v", got, want)
+	}
+	if len(problems) != 1 || !strings.Contains(problems[0], "datacenter group @europe not defined") {
+		t.Errorf("problems %v", problems)
+	}
+}
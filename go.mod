@@ -6,6 +6,7 @@ require (
 	github.com/akamai/AkamaiOPEN-edgegrid-golang v1.2.2
 	github.com/akamai/cli-common-golang v0.0.0-20210716202303-5a2a24172430
 	github.com/fatih/color v1.7.0
+	github.com/mattn/go-isatty v0.0.10
 	github.com/olekukonko/tablewriter v0.0.3
 	github.com/urfave/cli v1.22.5
 )
@@ -16,7 +17,6 @@ require (
 	github.com/google/uuid v1.1.1 // indirect
 	github.com/konsorten/go-windows-terminal-sequences v1.0.2 // indirect
 	github.com/mattn/go-colorable v0.1.2 // indirect
-	github.com/mattn/go-isatty v0.0.10 // indirect
 	github.com/mattn/go-runewidth v0.0.6 // indirect
 	github.com/mitchellh/go-homedir v1.1.0 // indirect
 	github.com/patrickmn/go-cache v2.1.0+incompatible // indirect
@@ -0,0 +1,113 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// metricFamily is a named metric and its samples
+type metricFamily struct {
+	name    string
+	help    string
+	typ     string
+	samples []string
+}
+
+// metricSet collects metrics in Prometheus text exposition format. Safe for concurrent use.
+type metricSet struct {
+	mu       sync.Mutex
+	families []*metricFamily
+	byName   map[string]*metricFamily
+}
+
+// newMetricSet returns an empty metric set
+func newMetricSet() *metricSet {
+
+	return &metricSet{byName: make(map[string]*metricFamily)}
+}
+
+// add adds a sample of the metric. labels are name, value pairs.
+func (m *metricSet) add(name string, typ string, help string, value float64, labels ...string) {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	family, ok := m.byName[name]
+	if !ok {
+		family = &metricFamily{name: name, help: help, typ: typ}
+		m.byName[name] = family
+		m.families = append(m.families, family)
+	}
+	var pairs []string
+	for i := 0; i+1 < len(labels); i += 2 {
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, labels[i], labelValueEscaper.Replace(labels[i+1])))
+	}
+	sample := name
+	if len(pairs) > 0 {
+		sample += "{" + strings.Join(pairs, ",") + "}"
+	}
+	family.samples = append(family.samples, sample+" "+formatMetricValue(value))
+}
+
+// gauge adds a gauge sample
+func (m *metricSet) gauge(name string, help string, value float64, labels ...string) {
+
+	m.add(name, "gauge", help, value, labels...)
+}
+
+// String returns the metrics in Prometheus text exposition format
+func (m *metricSet) String() string {
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	var b strings.Builder
+	for _, family := range m.families {
+		fmt.Fprintf(&b, "# HELP %s %s\n", family.name, family.help)
+		fmt.Fprintf(&b, "# TYPE %s %s\n", family.name, family.typ)
+		for _, sample := range family.samples {
+			b.WriteString(sample + "\n")
+		}
+	}
+	return b.String()
+}
+
+// formatMetricValue formats a sample value
+func formatMetricValue(value float64) string {
+
+	switch {
+	case math.IsNaN(value):
+		return "NaN"
+	case math.IsInf(value, 1):
+		return "+Inf"
+	case math.IsInf(value, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(value, 'g', -1, 64)
+}
+
+// boolValue returns 1 for true and 0 for false
+func boolValue(b bool) float64 {
+
+	if b {
+		return 1
+	}
+	return 0
+}
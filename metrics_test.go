@@ -0,0 +1,84 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"math"
+	"testing"
+)
+
+func TestFormatMetricValue(t *testing.T) {
+
+	tests := []struct {
+		value float64
+		want  string
+	}{
+		{0, "0"},
+		{1, "1"},
+		{-2.5, "-2.5"},
+		{0.125, "0.125"},
+		{1234567, "1.234567e+06"},
+		{math.NaN(), "NaN"},
+		{math.Inf(1), "+Inf"},
+		{math.Inf(-1), "-Inf"},
+		{boolValue(true), "1"},
+		{boolValue(false), "0"},
+	}
+	for _, tt := range tests {
+		if got := formatMetricValue(tt.value); got != tt.want {
+			t.Errorf("formatMetricValue(%v) = %s, want %s", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestMetricSetString(t *testing.T) {
+
+	tests := []struct {
+		name  string
+		build func(m *metricSet)
+		want  string
+	}{
+		{"empty", func(m *metricSet) {}, ""},
+		{"no labels", func(m *metricSet) {
+			m.gauge("gtm_up", "Whether the last collection succeeded.", 1)
+		}, "# HELP gtm_up Whether the last collection succeeded.\n# TYPE gtm_up gauge\ngtm_up 1\n"},
+		{"labels", func(m *metricSet) {
+			m.gauge("gtm_target_weight", "Traffic target weight.", 50, "domain", "example.akadns.net", "property", "www")
+		}, "# HELP gtm_target_weight Traffic target weight.\n# TYPE gtm_target_weight gauge\n" +
+			"gtm_target_weight{domain=\"example.akadns.net\",property=\"www\"} 50\n"},
+		{"escaped label values", func(m *metricSet) {
+			m.gauge("gtm_info", "Info.", 1, "name", "a\\b \"quoted\"\nnext")
+		}, "# HELP gtm_info Info.\n# TYPE gtm_info gauge\n" +
+			"gtm_info{name=\"a\\\\b \\\"quoted\\\"\\nnext\"} 1\n"},
+		{"samples grouped by family in first seen order", func(m *metricSet) {
+			m.gauge("gtm_b", "B.", 1, "dc", "1")
+			m.add("gtm_a", "counter", "A.", 2)
+			m.gauge("gtm_b", "B.", 3, "dc", "2")
+		}, "# HELP gtm_b B.\n# TYPE gtm_b gauge\ngtm_b{dc=\"1\"} 1\ngtm_b{dc=\"2\"} 3\n" +
+			"# HELP gtm_a A.\n# TYPE gtm_a counter\ngtm_a 2\n"},
+		{"odd label ignored", func(m *metricSet) {
+			m.gauge("gtm_up", "Up.", 0, "domain", "example.akadns.net", "orphan")
+		}, "# HELP gtm_up Up.\n# TYPE gtm_up gauge\ngtm_up{domain=\"example.akadns.net\"} 0\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newMetricSet()
+			tt.build(m)
+			if got := m.String(); got != tt.want {
+				t.Errorf("String() =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
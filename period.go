@@ -0,0 +1,190 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/reportsgtm-v1"
+	"github.com/urfave/cli"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const defaultPeriod = 15 * time.Minute
+
+var dayWeekDuration = regexp.MustCompile(`^(\d+)([dw])$`)
+
+// reportPeriod represents the reporting period requested with --period, --start and --end
+type reportPeriod struct {
+	length        time.Duration // zero if not specified
+	defaultLength time.Duration
+	start         *time.Time
+	end           *time.Time
+	location      *time.Location
+}
+
+// parsePeriodDuration parses a duration. In addition to Go durations, e.g. 6h, days (2d) and weeks (1w) are accepted.
+func parsePeriodDuration(value string) (time.Duration, error) {
+
+	if m := dayWeekDuration.FindStringSubmatch(value); m != nil {
+		n, _ := strconv.Atoi(m[1])
+		unit := 24 * time.Hour
+		if m[2] == "w" {
+			unit *= 7
+		}
+		return time.Duration(n) * unit, nil
+	}
+	return time.ParseDuration(value)
+}
+
+// parseTimeExpression parses an RFC3339 time, a time relative to now (now, -24h, -2d) or today/yesterday.
+// today and yesterday are the start of the day in loc.
+func parseTimeExpression(expr string, now time.Time, loc *time.Location) (time.Time, error) {
+
+	expr = strings.TrimSpace(expr)
+	local := now.In(loc)
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, loc)
+	switch strings.ToLower(expr) {
+	case "now":
+		return now, nil
+	case "today":
+		return midnight, nil
+	case "yesterday":
+		return midnight.AddDate(0, 0, -1), nil
+	}
+	if strings.HasPrefix(expr, "-") {
+		dur, err := parsePeriodDuration(expr[1:])
+		if err != nil {
+			return time.Time{}, fmt.Errorf("Invalid relative time %s", expr)
+		}
+		return now.Add(-dur), nil
+	}
+	t, err := time.Parse(time.RFC3339, expr)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Invalid time %s. Must be RFC3339, e.g. 2026-01-02T15:04:05Z, relative, e.g. -24h, now, today or yesterday", expr)
+	}
+	return t, nil
+}
+
+// parseReportPeriod parses the period, start, end and timezone flags. defaultLength is the period length if not specified.
+func parseReportPeriod(c *cli.Context, defaultLength time.Duration) (*reportPeriod, error) {
+
+	period := &reportPeriod{defaultLength: defaultLength, location: time.UTC}
+	if c.IsSet("timezone") {
+		loc, err := time.LoadLocation(c.String("timezone"))
+		if err != nil {
+			return nil, fmt.Errorf("Invalid timezone %s", c.String("timezone"))
+		}
+		period.location = loc
+	}
+	if c.IsSet("period") {
+		if c.IsSet("start") && c.IsSet("end") {
+			return nil, fmt.Errorf("period may not be combined with both start and end")
+		}
+		dur, err := parsePeriodDuration(c.String("period"))
+		if err != nil || dur <= 0 {
+			return nil, fmt.Errorf("Invalid period %s. Must be a duration, e.g. 30m, 6h or 2d", c.String("period"))
+		}
+		period.length = dur
+	}
+	now := time.Now()
+	if c.IsSet("start") {
+		t, err := parseTimeExpression(c.String("start"), now, period.location)
+		if err != nil {
+			return nil, err
+		}
+		period.start = &t
+	}
+	if c.IsSet("end") {
+		t, err := parseTimeExpression(c.String("end"), now, period.location)
+		if err != nil {
+			return nil, err
+		}
+		period.end = &t
+	}
+	if period.start != nil && period.end != nil && !period.start.Before(*period.end) {
+		return nil, fmt.Errorf("start must be before end")
+	}
+	return period, nil
+}
+
+// reportWindow returns the available report data window of datacenter or property traffic
+func reportWindow(trafficType string) (*reportsgtm.WindowResponse, error) {
+
+	if trafficType == "datacenter" {
+		return reportsgtm.GetDatacentersTrafficWindow()
+	}
+	return reportsgtm.GetPropertiesTrafficWindow()
+}
+
+// resolve returns the period start and end, validated against the available report data window.
+// An end later than the window is limited to the end of the window.
+func (p *reportPeriod) resolve(window *reportsgtm.WindowResponse) (time.Time, time.Time, error) {
+
+	var start, end time.Time
+	switch {
+	case p.start != nil && p.end != nil:
+		start, end = *p.start, *p.end
+	case p.start != nil:
+		start = *p.start
+		end = window.EndTime
+		if p.length > 0 {
+			end = start.Add(p.length)
+		}
+	case p.end != nil:
+		end = *p.end
+		start = end.Add(-p.periodLength())
+	default:
+		end = window.EndTime
+		start = end.Add(-p.periodLength())
+	}
+	if end.After(window.EndTime) {
+		end = window.EndTime
+	}
+	if start.Before(window.StartTime) {
+		return start, end, fmt.Errorf("Period start %s is before the earliest available report data %s", p.format(start), p.format(window.StartTime))
+	}
+	if !start.Before(end) {
+		return start, end, fmt.Errorf("Period start %s is not before the latest available report data %s", p.format(start), p.format(window.EndTime))
+	}
+	return start, end, nil
+}
+
+// periodLength returns the requested period length or the default
+func (p *reportPeriod) periodLength() time.Duration {
+
+	if p.length > 0 {
+		return p.length
+	}
+	return p.defaultLength
+}
+
+// format returns t as RFC3339 in the display timezone
+func (p *reportPeriod) format(t time.Time) string {
+
+	return t.In(p.location).Format(time.RFC3339)
+}
+
+// displayTime converts an RFC3339 report timestamp to the display timezone. Other values are returned unchanged.
+func (p *reportPeriod) displayTime(ts string) string {
+
+	t, err := time.Parse(time.RFC3339, ts)
+	if err != nil {
+		return ts
+	}
+	return p.format(t)
+}
@@ -0,0 +1,188 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/reportsgtm-v1"
+	"strings"
+	"testing"
+	"time"
+)
+
+// mustParseTime parses an RFC3339 time
+func mustParseTime(t *testing.T, value string) time.Time {
+
+	t.Helper()
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parsed
+}
+
+func TestParsePeriodDuration(t *testing.T) {
+
+	tests := []struct {
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{"30m", 30 * time.Minute, false},
+		{"6h", 6 * time.Hour, false},
+		{"1h30m", 90 * time.Minute, false},
+		{"2d", 48 * time.Hour, false},
+		{"1w", 7 * 24 * time.Hour, false},
+		{"d", 0, true},
+		{"2x", 0, true},
+		{"1.5d", 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.value, func(t *testing.T) {
+			got, err := parsePeriodDuration(tt.value)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("error = %v, want error %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("parsePeriodDuration() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseTimeExpression(t *testing.T) {
+
+	now := mustParseTime(t, "2026-03-10T05:30:00Z")
+	est := time.FixedZone("EST", -5*3600)
+	tests := []struct {
+		name    string
+		expr    string
+		loc     *time.Location
+		want    string
+		errText string
+	}{
+		{"now", "now", time.UTC, "2026-03-10T05:30:00Z", ""},
+		{"today utc", "today", time.UTC, "2026-03-10T00:00:00Z", ""},
+		{"today in timezone", "Today", est, "2026-03-10T05:00:00Z", ""},
+		{"yesterday in timezone", "yesterday", est, "2026-03-09T05:00:00Z", ""},
+		{"relative hours", "-24h", time.UTC, "2026-03-09T05:30:00Z", ""},
+		{"relative days", " -2d ", time.UTC, "2026-03-08T05:30:00Z", ""},
+		{"rfc3339", "2026-03-01T12:00:00+01:00", time.UTC, "2026-03-01T11:00:00Z", ""},
+		{"bad relative", "-2x", time.UTC, "", "Invalid relative time -2x"},
+		{"bad time", "2026-03-01", time.UTC, "", "Invalid time 2026-03-01"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseTimeExpression(tt.expr, now, tt.loc)
+			if tt.errText != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errText) {
+					t.Fatalf("error = %v, want %q", err, tt.errText)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !got.Equal(mustParseTime(t, tt.want)) {
+				t.Errorf("parseTimeExpression() = %s, want %s", got.UTC().Format(time.RFC3339), tt.want)
+			}
+		})
+	}
+}
+
+func TestParseReportPeriod(t *testing.T) {
+
+	tests := []struct {
+		name       string
+		args       []string
+		wantLength time.Duration
+		wantStart  bool
+		wantEnd    bool
+		errText    string
+	}{
+		{"default", nil, 0, false, false, ""},
+		{"period", []string{"--period", "2d"}, 48 * time.Hour, false, false, ""},
+		{"period and start", []string{"--period", "1h", "--start", "2026-03-01T00:00:00Z"}, time.Hour, true, false, ""},
+		{"start and end", []string{"--start", "2026-03-01T00:00:00Z", "--end", "2026-03-02T00:00:00Z"}, 0, true, true, ""},
+		{"period start and end", []string{"--period", "1h", "--start", "-2h", "--end", "-1h"}, 0, false, false, "period may not be combined with both start and end"},
+		{"zero period", []string{"--period", "0s"}, 0, false, false, "Invalid period 0s"},
+		{"bad period", []string{"--period", "soon"}, 0, false, false, "Invalid period soon"},
+		{"start after end", []string{"--start", "-1h", "--end", "-2h"}, 0, false, false, "start must be before end"},
+		{"bad timezone", []string{"--timezone", "Mars/Olympus"}, 0, false, false, "Invalid timezone Mars/Olympus"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			period, err := parseReportPeriod(newTestContext(t, "query-status", tt.args...), defaultPeriod)
+			if tt.errText != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errText) {
+					t.Fatalf("error = %v, want %q", err, tt.errText)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if period.length != tt.wantLength || (period.start != nil) != tt.wantStart || (period.end != nil) != tt.wantEnd {
+				t.Errorf("period length %s start %v end %v, want %s %v %v", period.length, period.start, period.end, tt.wantLength, tt.wantStart, tt.wantEnd)
+			}
+			if period.periodLength() <= 0 {
+				t.Errorf("period length %s", period.periodLength())
+			}
+		})
+	}
+}
+
+func TestReportPeriodResolve(t *testing.T) {
+
+	window := &reportsgtm.WindowResponse{StartTime: mustParseTime(t, "2026-03-01T00:00:00Z"), EndTime: mustParseTime(t, "2026-03-10T00:00:00Z")}
+	timePtr := func(value string) *time.Time {
+		parsed := mustParseTime(t, value)
+		return &parsed
+	}
+	tests := []struct {
+		name      string
+		period    *reportPeriod
+		wantStart string
+		wantEnd   string
+		errText   string
+	}{
+		{"default length at window end", &reportPeriod{defaultLength: defaultPeriod}, "2026-03-09T23:45:00Z", "2026-03-10T00:00:00Z", ""},
+		{"length at window end", &reportPeriod{length: 2 * time.Hour, defaultLength: defaultPeriod}, "2026-03-09T22:00:00Z", "2026-03-10T00:00:00Z", ""},
+		{"start only", &reportPeriod{defaultLength: defaultPeriod, start: timePtr("2026-03-09T00:00:00Z")}, "2026-03-09T00:00:00Z", "2026-03-10T00:00:00Z", ""},
+		{"start and length", &reportPeriod{length: time.Hour, defaultLength: defaultPeriod, start: timePtr("2026-03-09T00:00:00Z")}, "2026-03-09T00:00:00Z", "2026-03-09T01:00:00Z", ""},
+		{"end and length", &reportPeriod{length: time.Hour, defaultLength: defaultPeriod, end: timePtr("2026-03-05T12:00:00Z")}, "2026-03-05T11:00:00Z", "2026-03-05T12:00:00Z", ""},
+		{"start and end", &reportPeriod{defaultLength: defaultPeriod, start: timePtr("2026-03-02T00:00:00Z"), end: timePtr("2026-03-03T00:00:00Z")}, "2026-03-02T00:00:00Z", "2026-03-03T00:00:00Z", ""},
+		{"end limited to window", &reportPeriod{length: time.Hour, defaultLength: defaultPeriod, start: timePtr("2026-03-09T23:30:00Z")}, "2026-03-09T23:30:00Z", "2026-03-10T00:00:00Z", ""},
+		{"start before window", &reportPeriod{length: 2 * 24 * time.Hour, defaultLength: defaultPeriod, end: timePtr("2026-03-02T00:00:00Z")}, "", "", "is before the earliest available report data"},
+		{"start after window", &reportPeriod{defaultLength: defaultPeriod, start: timePtr("2026-03-11T00:00:00Z")}, "", "", "is not before the latest available report data"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tt.period.location = time.UTC
+			start, end, err := tt.period.resolve(window)
+			if tt.errText != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.errText) {
+					t.Fatalf("error = %v, want %q", err, tt.errText)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !start.Equal(mustParseTime(t, tt.wantStart)) || !end.Equal(mustParseTime(t, tt.wantEnd)) {
+				t.Errorf("resolve() = %s - %s, want %s - %s", tt.period.format(start), tt.period.format(end), tt.wantStart, tt.wantEnd)
+			}
+		})
+	}
+}
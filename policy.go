@@ -0,0 +1,342 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	"github.com/fatih/color"
+	"github.com/urfave/cli"
+	"io/ioutil"
+	"os"
+	"os/user"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const defaultDryrunReviewMinutes int = 60
+
+// Policy represents the guardrails evaluated against planned changes of mutating commands
+type Policy struct {
+	ProtectedProperties      []*ProtectedProperty `json:"protectedProperties"`
+	MaxDisabledTargetPercent float64              `json:"maxDisabledTargetPercent"`
+	FreezeWindows            []*FreezeWindow      `json:"freezeWindows"`
+	RequireDryrunDomains     []string             `json:"requireDryrunDomains"`
+	DryrunReviewMinutes      int                  `json:"dryrunReviewMinutes"`
+}
+
+// ProtectedProperty identifies properties which may never be changed. Property may be a glob pattern.
+type ProtectedProperty struct {
+	Domain   string `json:"domain"`
+	Property string `json:"property"`
+}
+
+// FreezeWindow is a period during which changes are not allowed. No domains means all domains.
+type FreezeWindow struct {
+	Start   string   `json:"start"`
+	End     string   `json:"end"`
+	Domains []string `json:"domains,omitempty"`
+	Reason  string   `json:"reason,omitempty"`
+}
+
+// changePlan represents the property changes a mutating command intends to submit
+type changePlan struct {
+	command       string
+	domain        string
+	properties    []*configgtm.Property
+	enabledBefore map[string]map[int]bool
+	weightsBefore map[string]map[int]float64
+	dryrun        bool
+}
+
+// newChangePlan creates an empty change plan for the command and domain
+func newChangePlan(c *cli.Context, domainName string, dryrun bool) *changePlan {
+
+	return &changePlan{command: c.Command.FullName(), domain: domainName, dryrun: dryrun, enabledBefore: make(map[string]map[int]bool), weightsBefore: make(map[string]map[int]float64)}
+}
+
+// addProperty adds a changed property and its traffic target enabled state and weights prior to the change
+func (plan *changePlan) addProperty(prop *configgtm.Property, enabledBefore map[int]bool, weightsBefore map[int]float64) {
+
+	plan.properties = append(plan.properties, prop)
+	plan.enabledBefore[prop.Name] = enabledBefore
+	plan.weightsBefore[prop.Name] = weightsBefore
+}
+
+// newlyDisabledTargets counts traffic targets the plan disables
+func (plan *changePlan) newlyDisabledTargets() int {
+
+	count := 0
+	for _, prop := range plan.properties {
+		for _, tt := range prop.TrafficTargets {
+			if !tt.Enabled && plan.enabledBefore[prop.Name][tt.DatacenterId] {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// hash identifies the planned property states. Used to match a dryrun review with the later change.
+func (plan *changePlan) hash() string {
+
+	props := append([]*configgtm.Property{}, plan.properties...)
+	sort.Slice(props, func(i, j int) bool { return props[i].Name < props[j].Name })
+	data, _ := json.Marshal(props)
+	sum := sha256.Sum256(append([]byte(plan.domain+"\n"), data...))
+	return hex.EncodeToString(sum[:])
+}
+
+// loadPolicy reads the policy file specified by --policy, or the default policy file if present
+func loadPolicy(c *cli.Context) (*Policy, error) {
+
+	policyFile := c.String("policy")
+	if policyFile == "" {
+		policyFile = filepath.Join(cliConfigDir(), "policy.json")
+		if _, err := os.Stat(policyFile); os.IsNotExist(err) {
+			return nil, nil
+		}
+	}
+	data, err := ioutil.ReadFile(policyFile)
+	if err != nil {
+		return nil, fmt.Errorf("Unable to read policy file %s. %s", policyFile, err.Error())
+	}
+	policy := &Policy{}
+	if err := json.Unmarshal(data, policy); err != nil {
+		return nil, fmt.Errorf("Unable to parse policy file %s. %s", policyFile, err.Error())
+	}
+	for _, fw := range policy.FreezeWindows {
+		if _, err := time.Parse(time.RFC3339, fw.Start); err != nil {
+			return nil, fmt.Errorf("Invalid freeze window start %s in policy file. Must be RFC3339", fw.Start)
+		}
+		if _, err := time.Parse(time.RFC3339, fw.End); err != nil {
+			return nil, fmt.Errorf("Invalid freeze window end %s in policy file. Must be RFC3339", fw.End)
+		}
+	}
+	if policy.DryrunReviewMinutes <= 0 {
+		policy.DryrunReviewMinutes = defaultDryrunReviewMinutes
+	}
+	return policy, nil
+}
+
+// domainInList returns true if domain is in list
+func domainInList(domain string, list []string) bool {
+
+	for _, d := range list {
+		if strings.EqualFold(d, domain) {
+			return true
+		}
+	}
+	return false
+}
+
+// evaluate returns the policy violations of the plan. requiresReview indicates a dryrun review is required.
+func (policy *Policy) evaluate(plan *changePlan) (violations []string, requiresReview bool, err error) {
+
+	for _, pp := range policy.ProtectedProperties {
+		if !strings.EqualFold(pp.Domain, plan.domain) {
+			continue
+		}
+		for _, prop := range plan.properties {
+			if matched, _ := path.Match(pp.Property, prop.Name); matched {
+				violations = append(violations, fmt.Sprintf("property %s is protected", prop.Name))
+			}
+		}
+	}
+
+	now := time.Now()
+	for _, fw := range policy.FreezeWindows {
+		start, _ := time.Parse(time.RFC3339, fw.Start)
+		end, _ := time.Parse(time.RFC3339, fw.End)
+		if now.Before(start) || now.After(end) {
+			continue
+		}
+		if len(fw.Domains) > 0 && !domainInList(plan.domain, fw.Domains) {
+			continue
+		}
+		msg := fmt.Sprintf("change freeze in effect from %s to %s", fw.Start, fw.End)
+		if fw.Reason != "" {
+			msg += " (" + fw.Reason + ")"
+		}
+		violations = append(violations, msg)
+	}
+
+	if policy.MaxDisabledTargetPercent > 0 {
+		if disabled := plan.newlyDisabledTargets(); disabled > 0 {
+			dom, err := configgtm.GetDomain(plan.domain)
+			if err != nil {
+				return nil, false, fmt.Errorf("Unable to retrieve domain %s to evaluate policy. %s", plan.domain, err.Error())
+			}
+			total := 0
+			for _, prop := range dom.Properties {
+				total += len(prop.TrafficTargets)
+			}
+			if total > 0 {
+				perc := float64(disabled) / float64(total) * 100
+				if perc > policy.MaxDisabledTargetPercent {
+					violations = append(violations, fmt.Sprintf("change disables %d of %d (%.1f%%) domain traffic targets. Maximum allowed is %.1f%%", disabled, total, perc, policy.MaxDisabledTargetPercent))
+				}
+			}
+		}
+	}
+
+	requiresReview = domainInList(plan.domain, policy.RequireDryrunDomains)
+	return violations, requiresReview, nil
+}
+
+// dryrunReviews maps domain and plan hash to the time the plan was reviewed with --dryrun
+type dryrunReviews map[string]string
+
+func dryrunReviewsFile() string {
+	return filepath.Join(cliConfigDir(), "dryrun-reviews.json")
+}
+
+func loadDryrunReviews() dryrunReviews {
+
+	reviews := make(dryrunReviews)
+	data, err := ioutil.ReadFile(dryrunReviewsFile())
+	if err == nil {
+		json.Unmarshal(data, &reviews)
+	}
+	return reviews
+}
+
+// recordDryrunReview saves the plan hash so the same change may later be applied
+func recordDryrunReview(plan *changePlan) error {
+
+	reviews := loadDryrunReviews()
+	reviews[plan.domain+"/"+plan.hash()] = time.Now().Format(time.RFC3339)
+	data, err := json.MarshalIndent(reviews, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(cliConfigDir(), 0700); err != nil {
+		return err
+	}
+	return ioutil.WriteFile(dryrunReviewsFile(), data, 0600)
+}
+
+// dryrunReviewed returns true if the identical plan was reviewed within the review period
+func dryrunReviewed(plan *changePlan, minutes int) bool {
+
+	reviewed, ok := loadDryrunReviews()[plan.domain+"/"+plan.hash()]
+	if !ok {
+		return false
+	}
+	reviewTime, err := time.Parse(time.RFC3339, reviewed)
+	if err != nil {
+		return false
+	}
+	return time.Since(reviewTime) <= time.Duration(minutes)*time.Minute
+}
+
+// PolicyOverride is the audit record written when policy violations are overridden
+type PolicyOverride struct {
+	Time       string
+	User       string
+	Command    string
+	Domain     string
+	Properties []string
+	Violations []string
+	Reason     string
+}
+
+// auditPolicyOverride appends an override record to the audit log
+func auditPolicyOverride(plan *changePlan, violations []string, reason string) error {
+
+	record := &PolicyOverride{Time: time.Now().Format(time.RFC3339), Command: plan.command, Domain: plan.domain, Violations: violations, Reason: reason}
+	if u, err := user.Current(); err == nil {
+		record.User = u.Username
+	}
+	for _, prop := range plan.properties {
+		record.Properties = append(record.Properties, prop.Name)
+	}
+	data, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(cliConfigDir(), 0700); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(filepath.Join(cliConfigDir(), "audit.log"), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = f.Write(append(data, '\n'))
+	return err
+}
+
+// enforcePolicy evaluates the policy against the plan. Returns an error if the change is not allowed.
+// Dryrun plans report violations without failing and are recorded as reviewed.
+func enforcePolicy(c *cli.Context, plan *changePlan) error {
+
+	if len(plan.properties) == 0 {
+		return nil
+	}
+	policy, err := loadPolicy(c)
+	if err != nil {
+		return cli.NewExitError(color.RedString(err.Error()), 1)
+	}
+	if policy == nil {
+		return nil
+	}
+	violations, requiresReview, err := policy.evaluate(plan)
+	if err != nil {
+		return cli.NewExitError(color.RedString(err.Error()), 1)
+	}
+
+	if plan.dryrun {
+		for _, v := range violations {
+			fmt.Fprintln(os.Stderr, color.YellowString("Policy violation: "+v))
+		}
+		if requiresReview {
+			if err := recordDryrunReview(plan); err != nil {
+				return cli.NewExitError(color.RedString("Unable to record dryrun review. "+err.Error()), 1)
+			}
+		}
+		return nil
+	}
+
+	if requiresReview && !dryrunReviewed(plan, policy.DryrunReviewMinutes) {
+		violations = append(violations, fmt.Sprintf("domain %s requires the change to be reviewed with --dryrun within the previous %d minutes", plan.domain, policy.DryrunReviewMinutes))
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+
+	reason := strings.TrimSpace(c.String("override-policy"))
+	if reason == "" {
+		msg := "Change not allowed by policy:\n"
+		for _, v := range violations {
+			msg += "  - " + v + "\n"
+		}
+		msg += "Use --override-policy \"<reason>\" to override"
+		return cli.NewExitError(color.RedString(msg), 1)
+	}
+	if err := auditPolicyOverride(plan, violations, reason); err != nil {
+		return cli.NewExitError(color.RedString("Unable to audit policy override. "+err.Error()), 1)
+	}
+	for _, v := range violations {
+		fmt.Fprintln(os.Stderr, color.YellowString("Policy violation overridden: "+v))
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// newTestPlan returns a plan changing the named properties of domain
+func newTestPlan(domain string, names ...string) *changePlan {
+
+	plan := &changePlan{command: "update-property", domain: domain, enabledBefore: make(map[string]map[int]bool), weightsBefore: make(map[string]map[int]float64)}
+	for _, name := range names {
+		prop := &configgtm.Property{Name: name, TrafficTargets: []*configgtm.TrafficTarget{{DatacenterId: 3131, Enabled: true, Weight: 1}}}
+		plan.addProperty(prop, targetEnabledState(prop), targetWeights(prop))
+	}
+	return plan
+}
+
+func TestPolicyEvaluate(t *testing.T) {
+
+	now := time.Now()
+	active := &FreezeWindow{Start: now.Add(-time.Hour).Format(time.RFC3339), End: now.Add(time.Hour).Format(time.RFC3339), Reason: "release"}
+	expired := &FreezeWindow{Start: now.Add(-2 * time.Hour).Format(time.RFC3339), End: now.Add(-time.Hour).Format(time.RFC3339)}
+	tests := []struct {
+		name           string
+		policy         *Policy
+		plan           *changePlan
+		violations     []string
+		requiresReview bool
+	}{
+		{"empty policy", &Policy{}, newTestPlan("example.akadns.net", "www"), nil, false},
+		{"protected property", &Policy{ProtectedProperties: []*ProtectedProperty{{Domain: "example.akadns.net", Property: "www"}}},
+			newTestPlan("example.akadns.net", "www", "api"), []string{"property www is protected"}, false},
+		{"protected property glob", &Policy{ProtectedProperties: []*ProtectedProperty{{Domain: "EXAMPLE.akadns.net", Property: "prod-*"}}},
+			newTestPlan("example.akadns.net", "prod-www", "test-www"), []string{"property prod-www is protected"}, false},
+		{"protected property other domain", &Policy{ProtectedProperties: []*ProtectedProperty{{Domain: "other.akadns.net", Property: "*"}}},
+			newTestPlan("example.akadns.net", "www"), nil, false},
+		{"active freeze window", &Policy{FreezeWindows: []*FreezeWindow{active}}, newTestPlan("example.akadns.net", "www"),
+			[]string{"change freeze in effect from " + active.Start + " to " + active.End + " (release)"}, false},
+		{"expired freeze window", &Policy{FreezeWindows: []*FreezeWindow{expired}}, newTestPlan("example.akadns.net", "www"), nil, false},
+		{"freeze window other domain", &Policy{FreezeWindows: []*FreezeWindow{{Start: active.Start, End: active.End, Domains: []string{"other.akadns.net"}}}},
+			newTestPlan("example.akadns.net", "www"), nil, false},
+		{"require dryrun", &Policy{RequireDryrunDomains: []string{"Example.akadns.net"}}, newTestPlan("example.akadns.net", "www"), nil, true},
+		{"max disabled without disabled targets", &Policy{MaxDisabledTargetPercent: 10}, newTestPlan("example.akadns.net", "www"), nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations, requiresReview, err := tt.policy.evaluate(tt.plan)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(violations, tt.violations) || requiresReview != tt.requiresReview {
+				t.Errorf("evaluate() = %q, %v, want %q, %v", violations, requiresReview, tt.violations, tt.requiresReview)
+			}
+		})
+	}
+}
+
+func TestChangePlanHash(t *testing.T) {
+
+	base := newTestPlan("example.akadns.net", "www", "api")
+	tests := []struct {
+		name  string
+		plan  *changePlan
+		equal bool
+	}{
+		{"identical plan", newTestPlan("example.akadns.net", "www", "api"), true},
+		{"property order", newTestPlan("example.akadns.net", "api", "www"), true},
+		{"other domain", newTestPlan("other.akadns.net", "www", "api"), false},
+		{"other properties", newTestPlan("example.akadns.net", "www"), false},
+		{"property state", func() *changePlan {
+			plan := newTestPlan("example.akadns.net", "www", "api")
+			plan.properties[0].TrafficTargets[0].Weight = 2
+			return plan
+		}(), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if equal := tt.plan.hash() == base.hash(); equal != tt.equal {
+				t.Errorf("hash equal = %v, want %v", equal, tt.equal)
+			}
+		})
+	}
+}
+
+func TestDryrunReviewed(t *testing.T) {
+
+	t.Setenv("HOME", t.TempDir())
+	plan := newTestPlan("example.akadns.net", "www")
+	if dryrunReviewed(plan, defaultDryrunReviewMinutes) {
+		t.Fatal("plan reviewed before dryrun")
+	}
+	if err := recordDryrunReview(plan); err != nil {
+		t.Fatal(err)
+	}
+	if !dryrunReviewed(newTestPlan("example.akadns.net", "www"), defaultDryrunReviewMinutes) {
+		t.Error("identical plan not reviewed")
+	}
+	if dryrunReviewed(newTestPlan("example.akadns.net", "api"), defaultDryrunReviewMinutes) {
+		t.Error("different plan reviewed")
+	}
+}
@@ -0,0 +1,121 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/reportsgtm-v1"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// targetEnabledState captures the enabled state of each property traffic target keyed by datacenter id
+func targetEnabledState(prop *configgtm.Property) map[int]bool {
+
+	state := make(map[int]bool)
+	for _, tt := range prop.TrafficTargets {
+		state[tt.DatacenterId] = tt.Enabled
+	}
+	return state
+}
+
+// targetWeights captures the weight of each property traffic target keyed by datacenter id
+func targetWeights(prop *configgtm.Property) map[int]float64 {
+
+	weights := make(map[int]float64)
+	for _, tt := range prop.TrafficTargets {
+		weights[tt.DatacenterId] = tt.Weight
+	}
+	return weights
+}
+
+// aliveDatacenters returns the datacenters with at least one alive IP in the most recent property IP status
+func aliveDatacenters(domainName string, propName string) (map[int]bool, error) {
+
+	alive := make(map[int]bool)
+	optArgs := map[string]string{"mostRecent": "true"}
+	ipStatus, err := reportsgtm.GetIpStatusPerProperty(domainName, propName, optArgs)
+	if err != nil {
+		return nil, err
+	}
+	for _, dr := range ipStatus.DataRows {
+		for _, dc := range dr.Datacenters {
+			for _, ip := range dc.IPs {
+				if ip.Alive {
+					alive[dc.DatacenterId] = true
+				}
+			}
+		}
+	}
+	return alive, nil
+}
+
+// checkPropertySafeguards verifies the planned property state retains an enabled, weighted and alive traffic target.
+// before is the target enabled state prior to the change.
+func checkPropertySafeguards(domainName string, prop *configgtm.Property, before map[int]bool) error {
+
+	if len(prop.TrafficTargets) == 0 {
+		return nil
+	}
+	var enabledTargets []*configgtm.TrafficTarget
+	var totalWeight float64
+	var newlyDisabled []int
+	for _, tt := range prop.TrafficTargets {
+		if tt.Enabled {
+			enabledTargets = append(enabledTargets, tt)
+			totalWeight += tt.Weight
+		} else if before[tt.DatacenterId] {
+			newlyDisabled = append(newlyDisabled, tt.DatacenterId)
+		}
+	}
+	if len(enabledTargets) == 0 {
+		return fmt.Errorf("Change would leave property %s with no enabled traffic targets. Use --force to override", prop.Name)
+	}
+	if strings.Contains(prop.Type, "weighted") && totalWeight == 0 {
+		return fmt.Errorf("Change would leave property %s with zero total traffic target weight. Use --force to override", prop.Name)
+	}
+	if len(newlyDisabled) == 0 {
+		return nil
+	}
+
+	alive, err := aliveDatacenters(domainName, prop.Name)
+	if err != nil {
+		return fmt.Errorf("Unable to verify traffic target liveness for property %s. %s. Use --force to override", prop.Name, err.Error())
+	}
+	return checkAliveSafeguard(prop, enabledTargets, newlyDisabled, alive)
+}
+
+// checkAliveSafeguard verifies an enabled traffic target remains alive when the newly disabled targets were alive
+func checkAliveSafeguard(prop *configgtm.Property, enabledTargets []*configgtm.TrafficTarget, newlyDisabled []int, alive map[int]bool) error {
+
+	for _, tt := range enabledTargets {
+		if alive[tt.DatacenterId] {
+			return nil
+		}
+	}
+	var disabledAlive []string
+	sort.Ints(newlyDisabled)
+	for _, dcID := range newlyDisabled {
+		if alive[dcID] {
+			disabledAlive = append(disabledAlive, strconv.Itoa(dcID))
+		}
+	}
+	if len(disabledAlive) > 0 {
+		return fmt.Errorf("Change would disable the only alive traffic target(s) (datacenter %s) in property %s. Use --force to override", strings.Join(disabledAlive, ", "), prop.Name)
+	}
+	return nil
+}
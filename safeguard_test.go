@@ -0,0 +1,91 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	"strings"
+	"testing"
+)
+
+func TestCheckPropertySafeguards(t *testing.T) {
+
+	tests := []struct {
+		name     string
+		propType string
+		targets  []*configgtm.TrafficTarget
+		before   map[int]bool
+		errText  string
+	}{
+		{"no targets", "weighted-round-robin", nil, nil, ""},
+		{"enabled target remains", "weighted-round-robin",
+			[]*configgtm.TrafficTarget{{DatacenterId: 3131, Enabled: true, Weight: 1}, {DatacenterId: 3132, Enabled: false, Weight: 1}},
+			map[int]bool{3131: true, 3132: false}, ""},
+		{"no enabled target left", "weighted-round-robin",
+			[]*configgtm.TrafficTarget{{DatacenterId: 3131, Enabled: false, Weight: 1}, {DatacenterId: 3132, Enabled: false, Weight: 1}},
+			map[int]bool{3131: true, 3132: false}, "no enabled traffic targets"},
+		{"zero total weight on weighted property", "weighted-round-robin",
+			[]*configgtm.TrafficTarget{{DatacenterId: 3131, Enabled: true, Weight: 0}, {DatacenterId: 3132, Enabled: true, Weight: 0}},
+			map[int]bool{3131: true, 3132: true}, "zero total traffic target weight"},
+		{"zero weight on non-weighted property", "failover",
+			[]*configgtm.TrafficTarget{{DatacenterId: 3131, Enabled: true, Weight: 0}},
+			map[int]bool{3131: true}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prop := &configgtm.Property{Name: "www", Type: tt.propType, TrafficTargets: tt.targets}
+			err := checkPropertySafeguards("example.akadns.net", prop, tt.before)
+			if tt.errText == "" {
+				if err != nil {
+					t.Fatalf("unexpected error %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.errText) {
+				t.Fatalf("error = %v, want %q", err, tt.errText)
+			}
+		})
+	}
+}
+
+func TestCheckAliveSafeguard(t *testing.T) {
+
+	enabled := []*configgtm.TrafficTarget{{DatacenterId: 3131, Enabled: true, Weight: 1}}
+	tests := []struct {
+		name          string
+		newlyDisabled []int
+		alive         map[int]bool
+		errText       string
+	}{
+		{"enabled target alive", []int{3132}, map[int]bool{3131: true, 3132: true}, ""},
+		{"disabling the last alive datacenter", []int{3133, 3132}, map[int]bool{3132: true, 3133: true}, "(datacenter 3132, 3133)"},
+		{"disabled target not alive", []int{3132}, map[int]bool{}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			prop := &configgtm.Property{Name: "www", Type: "weighted-round-robin"}
+			err := checkAliveSafeguard(prop, enabled, tt.newlyDisabled, tt.alive)
+			if tt.errText == "" {
+				if err != nil {
+					t.Fatalf("unexpected error %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.errText) {
+				t.Fatalf("error = %v, want %q", err, tt.errText)
+			}
+		})
+	}
+}
@@ -22,7 +22,7 @@ import (
 func setHelpTemplates() {
 	cli.AppHelpTemplate =
 		color.YellowString("Usage: \n") +
-			`{{if or (or (eq .HelpName "akamai-gtm update-datacenter") (eq .HelpName "akamai gtm update-datacenter")) (or (eq .HelpName "akamai-gtm update-property") (eq .HelpName "akamai gtm update-property")) (or (eq .HelpName "akamai-gtm query-status") (eq .HelpName "akamai gtm query-status"))}}` +
+			`{{if or (or (eq .HelpName "akamai-gtm update-datacenter") (eq .HelpName "akamai gtm update-datacenter")) (or (eq .HelpName "akamai-gtm update-property") (eq .HelpName "akamai gtm update-property")) (or (eq .HelpName "akamai-gtm query-status") (eq .HelpName "akamai gtm query-status")) (or (eq .HelpName "akamai-gtm update-liveness-tests") (eq .HelpName "akamai gtm update-liveness-tests")) (or (eq .HelpName "akamai-gtm replace-server") (eq .HelpName "akamai gtm replace-server")) (or (eq .HelpName "akamai-gtm search") (eq .HelpName "akamai gtm search")) (or (eq .HelpName "akamai-gtm lint") (eq .HelpName "akamai gtm lint")) (or (eq .HelpName "akamai-gtm completion") (eq .HelpName "akamai gtm completion")) (or (eq .HelpName "akamai-gtm health") (eq .HelpName "akamai gtm health")) (or (eq .HelpName "akamai-gtm traffic-report") (eq .HelpName "akamai gtm traffic-report")) (or (eq .HelpName "akamai-gtm serve-metrics") (eq .HelpName "akamai gtm serve-metrics")) (or (eq .HelpName "akamai-gtm check") (eq .HelpName "akamai gtm check")) (or (eq .HelpName "akamai-gtm analyze-distribution") (eq .HelpName "akamai gtm analyze-distribution")) (or (eq .HelpName "akamai-gtm anomalies") (eq .HelpName "akamai gtm anomalies"))}}` +
 			color.BlueString(`	{{if .UsageText}}{{.UsageText}}{{else}}{{.HelpName}}{{if .ArgsUsage}} {{.ArgsUsage}}{{end}}{{end}}`) +
 			`{{else}}` +
 			color.BlueString(`	{{if .UsageText}}{{.UsageText}}{{else}}{{.HelpName}}{{if .VisibleFlags}}{{range .VisibleFlags}} [--{{.Name}}]{{end}}{{end}}{{if .ArgsUsage}} {{.ArgsUsage}}{{end}}{{if .Commands}} <command> [sub-command]{{end}}{{end}}`) +
@@ -42,7 +42,7 @@ func setHelpTemplates() {
 			"\n\n{{end}}" +
 
 			"{{if .VisibleCommands}}" +
-			`{{if or (or (eq .HelpName "akamai-gtm update-datacenter") (eq .HelpName "akamai gtm update-datacenter")) (or (eq .HelpName "akamai-gtm update-property") (eq .HelpName "akamai gtm update-property")) (or (eq .HelpName "akamai-gtm query-status") (eq .HelpName "akamai gtm query-status"))}}` +
+			`{{if or (or (eq .HelpName "akamai-gtm update-datacenter") (eq .HelpName "akamai gtm update-datacenter")) (or (eq .HelpName "akamai-gtm update-property") (eq .HelpName "akamai gtm update-property")) (or (eq .HelpName "akamai-gtm query-status") (eq .HelpName "akamai gtm query-status")) (or (eq .HelpName "akamai-gtm update-liveness-tests") (eq .HelpName "akamai gtm update-liveness-tests")) (or (eq .HelpName "akamai-gtm replace-server") (eq .HelpName "akamai gtm replace-server")) (or (eq .HelpName "akamai-gtm search") (eq .HelpName "akamai gtm search")) (or (eq .HelpName "akamai-gtm lint") (eq .HelpName "akamai gtm lint")) (or (eq .HelpName "akamai-gtm completion") (eq .HelpName "akamai gtm completion")) (or (eq .HelpName "akamai-gtm health") (eq .HelpName "akamai gtm health")) (or (eq .HelpName "akamai-gtm traffic-report") (eq .HelpName "akamai gtm traffic-report")) (or (eq .HelpName "akamai-gtm serve-metrics") (eq .HelpName "akamai gtm serve-metrics")) (or (eq .HelpName "akamai-gtm check") (eq .HelpName "akamai gtm check")) (or (eq .HelpName "akamai-gtm analyze-distribution") (eq .HelpName "akamai gtm analyze-distribution")) (or (eq .HelpName "akamai-gtm anomalies") (eq .HelpName "akamai gtm anomalies"))}}` +
 			`{{else}}` +
 			color.YellowString("Built-In Commands:\n") +
 			`{{end}}` +
@@ -89,5 +89,22 @@ func setHelpTemplates() {
 			"{{if .Subcommands}}" +
 			"{{range .Subcommands}}   {{.Name}}\n{{end}}{{end}}"
 
-	cli.SubcommandHelpTemplate = cli.CommandHelpTemplate
+	cli.SubcommandHelpTemplate =
+		color.YellowString("Name: \n") +
+			"   {{.HelpName}}\n\n" +
+
+			`{{if .Description}}` +
+			color.YellowString("Description: \n") +
+			"   {{.Description}}\n\n" +
+			`{{end}}` +
+
+			color.YellowString("Usage: \n") +
+			color.BlueString("   {{if .UsageText}}{{.UsageText}}{{else}}{{.HelpName}} <sub-command> {{if .ArgsUsage}}{{.ArgsUsage}}{{end}}{{end}}\n\n") +
+
+			"{{if .VisibleCommands}}" +
+			color.YellowString("Sub-Commands: \n") +
+			"{{range .VisibleCommands}}" +
+			color.GreenString("   {{.Name}}") +
+			"{{if .Description}}\t{{.Description}}{{end}}\n" +
+			"{{end}}\n{{end}}"
 }
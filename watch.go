@@ -0,0 +1,198 @@
+// Copyright 2026. Akamai Technologies, Inc
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"github.com/akamai/AkamaiOPEN-edgegrid-golang/configgtm-v1_4"
+	"github.com/fatih/color"
+	"github.com/mattn/go-isatty"
+	"github.com/urfave/cli"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+const defaultWatchInterval = 10 * time.Second
+
+// clear screen and move cursor home
+const clearScreen = "\033[H\033[2J"
+
+var changedCell = color.New(color.FgBlack, color.BgYellow)
+
+// watchInterval is the value of --watch. The interval is optional: --watch or --watch=10s.
+type watchInterval struct {
+	interval time.Duration
+}
+
+// String returns the interval
+func (w *watchInterval) String() string {
+
+	if w.interval == 0 {
+		return ""
+	}
+	return w.interval.String()
+}
+
+// Set sets the interval. A value of true is the default interval.
+func (w *watchInterval) Set(value string) error {
+
+	switch value {
+	case "true":
+		w.interval = defaultWatchInterval
+		return nil
+	case "false":
+		w.interval = 0
+		return nil
+	}
+	interval, err := parseWatchInterval(value)
+	if err != nil {
+		return err
+	}
+	w.interval = interval
+	return nil
+}
+
+// IsBoolFlag allows --watch to be specified without a value
+func (w *watchInterval) IsBoolFlag() bool {
+
+	return true
+}
+
+// parseWatchInterval parses a duration, e.g. 30s, or a number of seconds
+func parseWatchInterval(value string) (time.Duration, error) {
+
+	interval, err := time.ParseDuration(value)
+	if err != nil {
+		secs, serr := strconv.Atoi(value)
+		if serr != nil {
+			return 0, fmt.Errorf("Invalid watch interval %s. Must be a duration, e.g. 30s, or a number of seconds", value)
+		}
+		interval = time.Duration(secs) * time.Second
+	}
+	if interval < time.Second {
+		return 0, fmt.Errorf("Invalid watch interval %s. Must be at least 1s", value)
+	}
+	return interval, nil
+}
+
+// cellHighlighter highlights table cells whose value changed since the previous refresh
+type cellHighlighter struct {
+	previous map[string]string
+	current  map[string]string
+	changed  int
+}
+
+// next starts a new refresh
+func (h *cellHighlighter) next() {
+
+	h.previous = h.current
+	h.current = make(map[string]string)
+	h.changed = 0
+}
+
+// cell records the value of the cell identified by key. Returns the value, highlighted if it changed since the previous refresh.
+// A nil highlighter returns the value unchanged.
+func (h *cellHighlighter) cell(key string, value string) string {
+
+	if h == nil {
+		return value
+	}
+	h.current[key] = value
+	if prev, ok := h.previous[key]; ok && prev != value {
+		h.changed++
+		return changedCell.Sprint(value)
+	}
+	return value
+}
+
+// stdoutIsTerminal returns true if output is displayed to a user
+func stdoutIsTerminal() bool {
+
+	return isatty.IsTerminal(os.Stdout.Fd()) || isatty.IsCygwinTerminal(os.Stdout.Fd())
+}
+
+// watchStatus refreshes status every interval until interrupted. Output is redrawn in place on a terminal and appended otherwise.
+// Each refresh after the first retrieves current domain configuration from GTM.
+func watchStatus(c *cli.Context, interval time.Duration) error {
+
+	inPlace := stdoutIsTerminal() && !c.Bool("json")
+	qsHighlighter = &cellHighlighter{}
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, os.Interrupt, syscall.SIGTERM)
+	defer signal.Stop(sigs)
+
+	type refresh struct {
+		output string
+		err    error
+	}
+	for refreshes := 0; ; refreshes++ {
+		results := make(chan refresh, 1)
+		refreshDomain := refreshes > 0 && qsDomain != nil
+		go func() {
+			if refreshDomain {
+				dom, err := configgtm.GetDomain(domainName)
+				if err != nil {
+					results <- refresh{err: err}
+					return
+				}
+				qsDomain = dom
+			}
+			objStatus, err := queryStatus(c)
+			if err != nil {
+				results <- refresh{err: err}
+				return
+			}
+			qsHighlighter.next()
+			output, err := renderQueryStatus(objStatus, c)
+			results <- refresh{output: output, err: err}
+		}()
+
+		var result refresh
+		select {
+		case <-sigs:
+			fmt.Fprintln(c.App.Writer, "")
+			return nil
+		case result = <-results:
+		}
+
+		frame := ""
+		if inPlace {
+			frame += clearScreen
+		}
+		if !c.Bool("json") {
+			frame += fmt.Sprintf("Every %s: query-status %s    %s", interval, domainName, qsPeriod.format(time.Now()))
+			if qsHighlighter.previous != nil && result.err == nil {
+				frame += fmt.Sprintf("    Changed: %d", qsHighlighter.changed)
+			}
+			frame += fmt.Sprintln("    (Ctrl-C to exit)")
+		}
+		if result.err != nil {
+			frame += fmt.Sprintln(color.RedString(statusErrorText(result.err)))
+		} else {
+			frame += result.output
+		}
+		fmt.Fprint(c.App.Writer, frame)
+
+		select {
+		case <-sigs:
+			fmt.Fprintln(c.App.Writer, "")
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}